@@ -0,0 +1,421 @@
+package livestatus
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// AvailabilityGroupBy represents the way availability report entries are aggregated.
+type AvailabilityGroupBy int
+
+const (
+	// GroupByObject reports availability for each host and service.
+	GroupByObject AvailabilityGroupBy = iota
+	// GroupByHostGroup aggregates availability by host group.
+	GroupByHostGroup
+	// GroupByServiceGroup aggregates availability by service group, host entries being left out.
+	GroupByServiceGroup
+)
+
+var availabilityColumns = []string{
+	"host_name",
+	"service_description",
+	"state",
+	"duration",
+	"in_downtime",
+	"in_host_downtime",
+	"is_flapping",
+	"in_notification_period",
+	"current_host_groups",
+	"current_service_groups",
+}
+
+// AvailabilityQuery represents an availability report query on the Livestatus `statehist` table.
+type AvailabilityQuery struct {
+	start   time.Time
+	end     time.Time
+	groupBy AvailabilityGroupBy
+	base    *Query
+
+	downtimeAsOK              bool
+	flappingAsOK              bool
+	excludeOutsideNotifPeriod bool
+}
+
+// NewAvailabilityQuery creates a new availability report query for the time range between start (inclusive)
+// and end (exclusive).
+func NewAvailabilityQuery(start, end time.Time) *AvailabilityQuery {
+	return &AvailabilityQuery{
+		start: start,
+		end:   end,
+		base:  NewQuery("statehist").Columns(availabilityColumns...),
+	}
+}
+
+// Filter appends a new filter to the query.
+func (aq *AvailabilityQuery) Filter(rule string) *AvailabilityQuery {
+	aq.base.Filter(rule)
+	return aq
+}
+
+// FilterValue appends a new filter to the query, comparing a column with a value using a given operator.
+func (aq *AvailabilityQuery) FilterValue(column, operator string, value interface{}) *AvailabilityQuery {
+	aq.base.FilterValue(column, operator, value)
+	return aq
+}
+
+// AuthUser restricts the report to the objects a given contact is authorized to see.
+func (aq *AvailabilityQuery) AuthUser(name string) *AvailabilityQuery {
+	aq.base.AuthUser(name)
+	return aq
+}
+
+// GroupBy sets the way report entries are aggregated.
+func (aq *AvailabilityQuery) GroupBy(g AvailabilityGroupBy) *AvailabilityQuery {
+	aq.groupBy = g
+	return aq
+}
+
+// DowntimeAsOK counts time spent in scheduled downtime as OK (or UP for hosts).
+func (aq *AvailabilityQuery) DowntimeAsOK() *AvailabilityQuery {
+	aq.downtimeAsOK = true
+	return aq
+}
+
+// FlappingAsOK counts time spent flapping as OK (or UP for hosts).
+func (aq *AvailabilityQuery) FlappingAsOK() *AvailabilityQuery {
+	aq.flappingAsOK = true
+	return aq
+}
+
+// ExcludeOutsideNotificationPeriod excludes the time spent outside of the notification period from the report
+// durations.
+func (aq *AvailabilityQuery) ExcludeOutsideNotificationPeriod() *AvailabilityQuery {
+	aq.excludeOutsideNotifPeriod = true
+	return aq
+}
+
+// Query returns the Livestatus query used to retrieve the state history.
+func (aq *AvailabilityQuery) Query() (*Query, error) {
+	if aq.start.IsZero() || aq.end.IsZero() {
+		return nil, fmt.Errorf("availability query time range is mandatory")
+	} else if !aq.end.After(aq.start) {
+		return nil, fmt.Errorf("availability query time range end %s is not after start %s", aq.end, aq.start)
+	} else if err := aq.base.Err(); err != nil {
+		return nil, err
+	}
+
+	q := aq.base.Clone()
+	q.FilterValue("time", ">=", aq.start)
+	q.FilterValue("time", "<", aq.end)
+
+	return q, nil
+}
+
+// StateDurations represents the time spent by an object in each state.
+type StateDurations struct {
+	OK          time.Duration
+	Warning     time.Duration
+	Critical    time.Duration
+	Unknown     time.Duration
+	Up          time.Duration
+	Down        time.Duration
+	Unreachable time.Duration
+	Unmonitored time.Duration
+	Excluded    time.Duration
+}
+
+// Total returns the total time covered by the durations, excluded time included.
+func (d StateDurations) Total() time.Duration {
+	return d.OK + d.Warning + d.Critical + d.Unknown + d.Up + d.Down + d.Unreachable + d.Unmonitored + d.Excluded
+}
+
+// Percent returns the percentage of a duration relative to the monitored time (i.e. the total time minus the
+// unmonitored and excluded time).
+func (d StateDurations) Percent(v time.Duration) float64 {
+	total := d.Total() - d.Unmonitored - d.Excluded
+	if total <= 0 {
+		return 0
+	}
+
+	return float64(v) / float64(total) * 100
+}
+
+// Availability returns the percentage of monitored time spent in OK or UP state.
+func (d StateDurations) Availability() float64 {
+	return d.Percent(d.OK + d.Up)
+}
+
+func (d *StateDurations) add(o StateDurations) {
+	d.OK += o.OK
+	d.Warning += o.Warning
+	d.Critical += o.Critical
+	d.Unknown += o.Unknown
+	d.Up += o.Up
+	d.Down += o.Down
+	d.Unreachable += o.Unreachable
+	d.Unmonitored += o.Unmonitored
+	d.Excluded += o.Excluded
+}
+
+// AvailabilityEntry represents an availability report entry, either for a single host or service or for a group
+// of objects.
+type AvailabilityEntry struct {
+	HostName           string
+	ServiceDescription string
+	Group              string
+	Durations          StateDurations
+}
+
+// AvailabilityReport represents an availability report.
+type AvailabilityReport struct {
+	Start   time.Time
+	End     time.Time
+	Entries []AvailabilityEntry
+}
+
+// Availability computes an availability report using the Livestatus `statehist` table.
+func (c *Client) Availability(aq *AvailabilityQuery) (*AvailabilityReport, error) {
+	q, err := aq.Query()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Exec(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return aq.report(resp.Records)
+}
+
+func (aq *AvailabilityQuery) report(records []Record) (*AvailabilityReport, error) {
+	entries := map[[3]string]*AvailabilityEntry{}
+
+	for _, r := range records {
+		row, err := newStateHistRow(r)
+		if err != nil {
+			return nil, err
+		}
+
+		d := aq.durations(row)
+
+		keys := [][3]string{}
+		switch aq.groupBy {
+		case GroupByHostGroup:
+			for _, g := range row.hostGroups {
+				keys = append(keys, [3]string{"", "", g})
+			}
+
+		case GroupByServiceGroup:
+			for _, g := range row.serviceGroups {
+				keys = append(keys, [3]string{"", "", g})
+			}
+
+		default:
+			keys = append(keys, [3]string{row.hostName, row.serviceDescription, ""})
+		}
+
+		for _, key := range keys {
+			e, ok := entries[key]
+			if !ok {
+				e = &AvailabilityEntry{
+					HostName:           key[0],
+					ServiceDescription: key[1],
+					Group:              key[2],
+				}
+				entries[key] = e
+			}
+			e.Durations.add(d)
+		}
+	}
+
+	report := &AvailabilityReport{
+		Start:   aq.start,
+		End:     aq.end,
+		Entries: []AvailabilityEntry{},
+	}
+
+	for _, e := range entries {
+		report.Entries = append(report.Entries, *e)
+	}
+
+	sort.Slice(report.Entries, func(i, j int) bool {
+		a, b := report.Entries[i], report.Entries[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		} else if a.HostName != b.HostName {
+			return a.HostName < b.HostName
+		}
+		return a.ServiceDescription < b.ServiceDescription
+	})
+
+	return report, nil
+}
+
+func (aq *AvailabilityQuery) durations(row stateHistRow) StateDurations {
+	d := StateDurations{}
+	isHost := row.serviceDescription == ""
+
+	state := row.state
+	if aq.excludeOutsideNotifPeriod && !row.inNotificationPeriod {
+		d.Excluded = row.duration
+		return d
+	} else if state >= 0 && (aq.downtimeAsOK && row.inDowntime || aq.flappingAsOK && row.isFlapping) {
+		state = 0
+	}
+
+	switch {
+	case state < 0:
+		d.Unmonitored = row.duration
+	case isHost && state == 0:
+		d.Up = row.duration
+	case isHost && state == 1:
+		d.Down = row.duration
+	case isHost:
+		d.Unreachable = row.duration
+	case state == 0:
+		d.OK = row.duration
+	case state == 1:
+		d.Warning = row.duration
+	case state == 2:
+		d.Critical = row.duration
+	default:
+		d.Unknown = row.duration
+	}
+
+	return d
+}
+
+type stateHistRow struct {
+	hostName             string
+	serviceDescription   string
+	state                int64
+	duration             time.Duration
+	inDowntime           bool
+	isFlapping           bool
+	inNotificationPeriod bool
+	hostGroups           []string
+	serviceGroups        []string
+}
+
+func newStateHistRow(r Record) (stateHistRow, error) {
+	rr := r.Reader()
+
+	inDowntime, inHostDowntime := rr.Bool("in_downtime"), rr.Bool("in_host_downtime")
+
+	row := stateHistRow{
+		hostName:             rr.String("host_name"),
+		serviceDescription:   rr.String("service_description"),
+		state:                rr.Int("state"),
+		duration:             time.Duration(rr.Int("duration")) * time.Second,
+		inDowntime:           inDowntime || inHostDowntime,
+		isFlapping:           rr.Bool("is_flapping"),
+		inNotificationPeriod: rr.Bool("in_notification_period"),
+		hostGroups:           rr.StringSlice("current_host_groups"),
+		serviceGroups:        rr.StringSlice("current_service_groups"),
+	}
+
+	return row, rr.Err()
+}
+
+var availabilityCSVHeader = []string{
+	"host_name",
+	"service_description",
+	"group",
+	"ok",
+	"warning",
+	"critical",
+	"unknown",
+	"up",
+	"down",
+	"unreachable",
+	"unmonitored",
+	"excluded",
+	"availability",
+}
+
+// WriteCSV writes the report as CSV, durations being expressed in seconds and availability as a percentage.
+func (ar *AvailabilityReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(availabilityCSVHeader); err != nil {
+		return err
+	}
+
+	for _, e := range ar.Entries {
+		d := e.Durations
+
+		row := []string{e.HostName, e.ServiceDescription, e.Group}
+		for _, v := range []time.Duration{
+			d.OK, d.Warning, d.Critical, d.Unknown, d.Up, d.Down, d.Unreachable, d.Unmonitored, d.Excluded,
+		} {
+			row = append(row, strconv.FormatInt(int64(v/time.Second), 10))
+		}
+		row = append(row, strconv.FormatFloat(d.Availability(), 'f', 3, 64))
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteJSON writes the report as JSON, durations being expressed in seconds and availability as a percentage.
+func (ar *AvailabilityReport) WriteJSON(w io.Writer) error {
+	type jsonEntry struct {
+		HostName           string  `json:"host_name,omitempty"`
+		ServiceDescription string  `json:"service_description,omitempty"`
+		Group              string  `json:"group,omitempty"`
+		OK                 int64   `json:"ok"`
+		Warning            int64   `json:"warning"`
+		Critical           int64   `json:"critical"`
+		Unknown            int64   `json:"unknown"`
+		Up                 int64   `json:"up"`
+		Down               int64   `json:"down"`
+		Unreachable        int64   `json:"unreachable"`
+		Unmonitored        int64   `json:"unmonitored"`
+		Excluded           int64   `json:"excluded"`
+		Availability       float64 `json:"availability"`
+	}
+
+	out := struct {
+		Start   int64       `json:"start"`
+		End     int64       `json:"end"`
+		Entries []jsonEntry `json:"entries"`
+	}{
+		Start:   ar.Start.Unix(),
+		End:     ar.End.Unix(),
+		Entries: []jsonEntry{},
+	}
+
+	for _, e := range ar.Entries {
+		d := e.Durations
+
+		out.Entries = append(out.Entries, jsonEntry{
+			HostName:           e.HostName,
+			ServiceDescription: e.ServiceDescription,
+			Group:              e.Group,
+			OK:                 int64(d.OK / time.Second),
+			Warning:            int64(d.Warning / time.Second),
+			Critical:           int64(d.Critical / time.Second),
+			Unknown:            int64(d.Unknown / time.Second),
+			Up:                 int64(d.Up / time.Second),
+			Down:               int64(d.Down / time.Second),
+			Unreachable:        int64(d.Unreachable / time.Second),
+			Unmonitored:        int64(d.Unmonitored / time.Second),
+			Excluded:           int64(d.Excluded / time.Second),
+			Availability:       d.Availability(),
+		})
+	}
+
+	return json.NewEncoder(w).Encode(out)
+}
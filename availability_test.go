@@ -0,0 +1,169 @@
+package livestatus
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testStateHistRecords() []Record {
+	row := func(host, service string, state, duration float64, downtime, flapping, notifPeriod float64,
+		hostGroups, serviceGroups []interface{}) Record {
+		return Record{
+			"host_name":              host,
+			"service_description":    service,
+			"state":                  state,
+			"duration":               duration,
+			"in_downtime":            downtime,
+			"in_host_downtime":       0.0,
+			"is_flapping":            flapping,
+			"in_notification_period": notifPeriod,
+			"current_host_groups":    hostGroups,
+			"current_service_groups": serviceGroups,
+		}
+	}
+
+	hg := []interface{}{"group1"}
+	sg := []interface{}{"group2"}
+
+	return []Record{
+		row("host1", "", 0, 3000, 0, 0, 1, hg, []interface{}{}),
+		row("host1", "", 1, 600, 1, 0, 1, hg, []interface{}{}),
+		row("host1", "service1", 0, 2400, 0, 0, 1, hg, sg),
+		row("host1", "service1", 2, 600, 0, 1, 1, hg, sg),
+		row("host1", "service1", 1, 300, 0, 0, 0, hg, sg),
+		row("host1", "service1", -1, 300, 0, 0, 1, hg, sg),
+	}
+}
+
+func Test_AvailabilityQuery(t *testing.T) {
+	expected := `GET statehist
+Columns: ` + strings.Join(availabilityColumns, " ") + `
+Filter: host_name = host1
+Filter: time >= 1439629200
+Filter: time < 1439632800
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	aq := NewAvailabilityQuery(time.Unix(1439629200, 0), time.Unix(1439632800, 0))
+	aq.FilterValue("host_name", "=", "host1")
+
+	q, err := aq.Query()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := q.String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_AvailabilityReport(t *testing.T) {
+	aq := NewAvailabilityQuery(time.Unix(1439629200, 0), time.Unix(1439632800, 0))
+
+	report, err := aq.report(testStateHistRecords())
+	if err != nil {
+		t.Fatal(err)
+	} else if len(report.Entries) != 2 {
+		t.Fatalf("\nExpected 2 entries\nbut got  %d\n", len(report.Entries))
+	}
+
+	host, service := report.Entries[0].Durations, report.Entries[1].Durations
+
+	if host.Up != 3000*time.Second || host.Down != 600*time.Second {
+		t.Logf("\nUnexpected host durations %#v\n", host)
+		t.Fail()
+	} else if service.OK != 2400*time.Second || service.Critical != 600*time.Second ||
+		service.Warning != 300*time.Second || service.Unmonitored != 300*time.Second {
+		t.Logf("\nUnexpected service durations %#v\n", service)
+		t.Fail()
+	} else if v := service.Availability(); v != 2400.0/3300*100 {
+		t.Logf("\nExpected %f\nbut got  %f\n", 2400.0/3300*100, v)
+		t.Fail()
+	}
+
+	aq.DowntimeAsOK().FlappingAsOK().ExcludeOutsideNotificationPeriod()
+
+	report, err = aq.report(testStateHistRecords())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host, service = report.Entries[0].Durations, report.Entries[1].Durations
+
+	if host.Up != 3600*time.Second || host.Availability() != 100 {
+		t.Logf("\nUnexpected host durations %#v\n", host)
+		t.Fail()
+	} else if service.OK != 3000*time.Second || service.Excluded != 300*time.Second {
+		t.Logf("\nUnexpected service durations %#v\n", service)
+		t.Fail()
+	}
+}
+
+func Test_AvailabilityReportGroupBy(t *testing.T) {
+	aq := NewAvailabilityQuery(time.Unix(1439629200, 0), time.Unix(1439632800, 0))
+
+	report, err := aq.GroupBy(GroupByHostGroup).report(testStateHistRecords())
+	if err != nil {
+		t.Fatal(err)
+	} else if len(report.Entries) != 1 || report.Entries[0].Group != "group1" {
+		t.Fatalf("\nUnexpected entries %#v\n", report.Entries)
+	} else if total := report.Entries[0].Durations.Total(); total != 7200*time.Second {
+		t.Logf("\nExpected %s\nbut got  %s\n", 7200*time.Second, total)
+		t.Fail()
+	}
+
+	report, err = aq.GroupBy(GroupByServiceGroup).report(testStateHistRecords())
+	if err != nil {
+		t.Fatal(err)
+	} else if len(report.Entries) != 1 || report.Entries[0].Group != "group2" {
+		t.Fatalf("\nUnexpected entries %#v\n", report.Entries)
+	} else if total := report.Entries[0].Durations.Total(); total != 3600*time.Second {
+		t.Logf("\nExpected %s\nbut got  %s\n", 3600*time.Second, total)
+		t.Fail()
+	}
+}
+
+func Test_AvailabilityReportExport(t *testing.T) {
+	report := &AvailabilityReport{
+		Start: time.Unix(1439629200, 0),
+		End:   time.Unix(1439632800, 0),
+		Entries: []AvailabilityEntry{
+			{
+				HostName: "host1",
+				Durations: StateDurations{
+					Up:   2700 * time.Second,
+					Down: 900 * time.Second,
+				},
+			},
+		},
+	}
+
+	expected := "host_name,service_description,group,ok,warning,critical,unknown,up,down,unreachable," +
+		"unmonitored,excluded,availability\nhost1,,,0,0,0,0,2700,900,0,0,0,75.000\n"
+
+	buf := bytes.NewBuffer(nil)
+	if err := report.WriteCSV(buf); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, buf.String())
+		t.Fail()
+	}
+
+	expected = `{"start":1439629200,"end":1439632800,"entries":[{"host_name":"host1","ok":0,"warning":0,` +
+		`"critical":0,"unknown":0,"up":2700,"down":900,"unreachable":0,"unmonitored":0,"excluded":0,` +
+		`"availability":75}]}` + "\n"
+
+	buf.Reset()
+	if err := report.WriteJSON(buf); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, buf.String())
+		t.Fail()
+	}
+}
@@ -0,0 +1,203 @@
+package livestatus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// CommandBatch represents a batch of Livestatus commands sent over a single connection.
+type CommandBatch struct {
+	commands []*Command
+
+	chunkSize    int
+	rate         float64
+	writeTimeout time.Duration
+	ctx          context.Context
+}
+
+// NewCommandBatch creates a new Livestatus commands batch instance.
+func NewCommandBatch(cmds ...*Command) *CommandBatch {
+	return &CommandBatch{commands: cmds, ctx: context.Background()}
+}
+
+// Add appends new commands to the batch.
+func (b *CommandBatch) Add(cmds ...*Command) *CommandBatch {
+	b.commands = append(b.commands, cmds...)
+	return b
+}
+
+// Len returns the number of commands present in the batch.
+func (b *CommandBatch) Len() int {
+	return len(b.commands)
+}
+
+// Commands returns the commands present in the batch.
+func (b *CommandBatch) Commands() []*Command {
+	return b.commands
+}
+
+// ChunkSize sets the maximum number of commands sent in a single write. A value of 0 sends all the commands at
+// once.
+func (b *CommandBatch) ChunkSize(n int) *CommandBatch {
+	b.chunkSize = n
+	return b
+}
+
+// Rate sets the maximum number of commands sent per second, chunks being delayed accordingly to avoid flooding
+// the monitoring core. A value of 0 disables pacing. When no chunk size is set, commands are sent one at a time.
+//
+// Each chunk is sent once the time needed to send the previous commands at the given rate has elapsed since the
+// beginning of the batch, as measured by the client clock.
+func (b *CommandBatch) Rate(perSecond float64) *CommandBatch {
+	b.rate = perSecond
+	return b
+}
+
+// WriteTimeout sets the connection timeout for the write operations of the whole batch, pacing delays included.
+// A value of 0 disables the timeout.
+func (b *CommandBatch) WriteTimeout(timeout time.Duration) *CommandBatch {
+	b.writeTimeout = timeout
+	return b
+}
+
+// Context sets the context whose cancellation interrupts the pacing delays, the commands not yet sent being
+// reported as failed in a BatchError.
+func (b *CommandBatch) Context(ctx context.Context) *CommandBatch {
+	b.ctx = ctx
+	return b
+}
+
+// Err returns an error if one of the batch commands is invalid.
+func (b CommandBatch) Err() error {
+	for i, cmd := range b.commands {
+		if err := cmd.Err(); err != nil {
+			return fmt.Errorf("command #%d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// String returns a string representation of the Livestatus commands batch.
+func (b CommandBatch) String() string {
+	s := ""
+	for _, cmd := range b.commands {
+		s += cmd.String()
+	}
+
+	return s
+}
+
+func (b CommandBatch) handle(conn net.Conn, clock Clock) (*Response, error) {
+	// Check all the commands beforehand, ensuring nothing is sent for an invalid batch
+	if err := b.Err(); err != nil {
+		return nil, err
+	}
+
+	if b.writeTimeout > 0 {
+		conn.SetWriteDeadline(time.Now().Add(b.writeTimeout))
+	} else {
+		// disable timeout
+		conn.SetWriteDeadline(time.Time{})
+	}
+
+	size := b.chunkSize
+	if size <= 0 {
+		size = len(b.commands)
+		if b.rate > 0 {
+			size = 1
+		}
+	}
+
+	ctx := b.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	begin := clock.Now()
+
+	for start := 0; start < len(b.commands); start += size {
+		end := start + size
+		if end > len(b.commands) {
+			end = len(b.commands)
+		}
+
+		if start > 0 && b.rate > 0 {
+			next := begin.Add(time.Duration(float64(start) / b.rate * float64(time.Second)))
+
+			if delay := next.Sub(clock.Now()); delay > 0 && !sleepContext(ctx, delay) {
+				return nil, BatchError{
+					Written: b.commands[:start],
+					Failed:  b.commands[start:],
+					Err:     ctx.Err(),
+				}
+			}
+		}
+
+		if written, err := b.write(conn, b.commands[start:end], clock.Now()); err != nil {
+			return nil, BatchError{
+				Written: b.commands[:start+written],
+				Failed:  b.commands[start+written:],
+				Err:     err,
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// write sends a chunk of commands, returning the number of commands fully written.
+func (b CommandBatch) write(conn net.Conn, cmds []*Command, now time.Time) (int, error) {
+	parts := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		parts[i] = cmd.render(now)
+	}
+
+	data := strings.Join(parts, "")
+
+	n, err := conn.Write([]byte(data))
+	if err == nil && n != len(data) {
+		err = fmt.Errorf("incomplete write to livestatus. Wrote %d bytes while %d were to be written", n, len(data))
+	}
+
+	if err == nil {
+		return len(cmds), nil
+	}
+
+	written := 0
+	for _, p := range parts {
+		if n -= len(p); n < 0 {
+			break
+		}
+		written++
+	}
+
+	return written, err
+}
+
+func (b CommandBatch) keepAlive() bool {
+	return true
+}
+
+// BatchError represents a commands batch partial failure.
+type BatchError struct {
+	// Written contains the commands fully written to the connection before the failure.
+	Written []*Command
+	// Failed contains the commands not or only partially written.
+	Failed []*Command
+
+	Err error
+}
+
+func (e BatchError) Error() string {
+	return fmt.Sprintf("batch failed after %d of %d commands: %v", len(e.Written), len(e.Written)+len(e.Failed),
+		e.Err)
+}
+
+// Unwrap returns the underlying write error.
+func (e BatchError) Unwrap() error {
+	return e.Err
+}
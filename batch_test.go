@@ -0,0 +1,181 @@
+package livestatus
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_CommandBatch(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		received = append(received, req)
+		return 200, ""
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	b := NewCommandBatch(NewCommand("command1", "arg1")).
+		Add(NewCommand("command2"), NewCommand("command3")).
+		ChunkSize(2).
+		Rate(100)
+
+	start := time.Now()
+
+	if _, err := c.Exec(b); err != nil {
+		t.Fatal(err)
+	} else if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Logf("\nExpected batch to be paced\nbut took %s\n", elapsed)
+		t.Fail()
+	}
+
+	// Ensure connection is still usable afterwards
+	if _, err := c.Exec(NewQuery("table1")); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(received) != 4 {
+		t.Fatalf("\nExpected 4 requests\nbut got  %d\n", len(received))
+	}
+
+	for i, prefix := range []string{"COMMAND [", "COMMAND [", "COMMAND [", "GET table1"} {
+		if !strings.HasPrefix(received[i], prefix) {
+			t.Logf("\nExpected request #%d to start with %q\nbut got  %q\n", i+1, prefix, received[i])
+			t.Fail()
+		}
+	}
+
+	if !strings.HasSuffix(received[0], "] command1;arg1\n\n") {
+		t.Logf("\nUnexpected request %q\n", received[0])
+		t.Fail()
+	}
+}
+
+func Test_CommandBatchInvalid(t *testing.T) {
+	b := NewCommandBatch(NewCommand("command1"), NewCommand("command2", "arg\n"))
+
+	var uerr UnsafeValueError
+	if err := b.Err(); !errors.As(err, &uerr) || !strings.HasPrefix(err.Error(), "command #2: ") {
+		t.Logf("\nExpected UnsafeValueError for command #2\nbut got  %#v\n", err)
+		t.Fail()
+	}
+}
+
+type failingConn struct {
+	net.Conn
+	limit   int
+	written string
+}
+
+func (c *failingConn) Write(data []byte) (int, error) {
+	n := len(data)
+	if n > c.limit {
+		n = c.limit
+	}
+	c.limit -= n
+	c.written += string(data[:n])
+
+	if n < len(data) {
+		return n, errors.New("connection reset")
+	}
+
+	return n, nil
+}
+
+func (c *failingConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+func Test_CommandBatchPartialFailure(t *testing.T) {
+	cmds := []*Command{NewCommand("command1"), NewCommand("command2"), NewCommand("command3")}
+
+	conn := &failingConn{limit: len(cmds[0].String()) + 5}
+
+	_, err := NewCommandBatch(cmds...).handle(conn, SystemClock)
+
+	var berr BatchError
+	if !errors.As(err, &berr) {
+		t.Fatalf("\nExpected BatchError\nbut got  %#v\n", err)
+	} else if len(berr.Written) != 1 || berr.Written[0] != cmds[0] || len(berr.Failed) != 2 {
+		t.Logf("\nUnexpected batch error %#v\n", berr)
+		t.Fail()
+	} else if berr.Err.Error() != "connection reset" {
+		t.Logf("\nExpected %q\nbut got  %q\n", "connection reset", berr.Err)
+		t.Fail()
+	}
+}
+
+// stepClock represents a clock advancing by a given step each time it is read.
+type stepClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+func (c *stepClock) Now() time.Time {
+	c.now = c.now.Add(c.step)
+	return c.now
+}
+
+func Test_CommandBatchPacing(t *testing.T) {
+	cmds := []*Command{NewCommand("command1"), NewCommand("command2"), NewCommand("command3")}
+
+	conn := &failingConn{limit: 1 << 20}
+
+	// Commands are already late when the clock advances faster than the rate, thus no delay is expected
+	start := time.Now()
+
+	clock := &stepClock{now: time.Unix(1439633040, 0), step: time.Second}
+
+	_, err := NewCommandBatch(cmds...).Rate(10).handle(conn, clock)
+	if err != nil {
+		t.Fatal(err)
+	} else if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Logf("\nExpected batch not to be delayed\nbut took %s\n", elapsed)
+		t.Fail()
+	}
+
+	if n := strings.Count(conn.written, "COMMAND "); n != 3 {
+		t.Logf("\nExpected 3 commands\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+func Test_CommandBatchCancel(t *testing.T) {
+	cmds := []*Command{NewCommand("command1"), NewCommand("command2"), NewCommand("command3")}
+
+	conn := &failingConn{limit: 1 << 20}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err := NewCommandBatch(cmds...).Rate(1).Context(ctx).handle(conn, fakeClock{now: time.Unix(1439633040, 0)})
+
+	var berr BatchError
+	if !errors.As(err, &berr) {
+		t.Fatalf("\nExpected BatchError\nbut got  %#v\n", err)
+	} else if len(berr.Written) != 1 || len(berr.Failed) != 2 || !errors.Is(err, context.DeadlineExceeded) {
+		t.Logf("\nUnexpected batch error %#v\n", berr)
+		t.Fail()
+	} else if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Logf("\nExpected pacing delay to be interrupted\nbut took %s\n", elapsed)
+		t.Fail()
+	}
+}
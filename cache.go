@@ -0,0 +1,326 @@
+package livestatus
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// CacheOptions represents the options of a cached Livestatus client.
+type CacheOptions struct {
+	// TTL is the default duration for which query responses are kept in cache.
+	TTL time.Duration
+
+	// MaxSize is the approximate upper limit in bytes of the memory used by the cached responses. A value of 0
+	// disables the limit.
+	MaxSize int
+
+	// Invalidate returns the list of tables whose cached responses have to be invalidated once a given command
+	// has been sent. If nil, the whole cache is invalidated after each command.
+	Invalidate func(cmd *Command) []string
+}
+
+// CachedClient represents a Livestatus client caching query responses.
+//
+// Queries are identified by their string representation, thus queries using different headers (e.g. AuthUser)
+// are cached separately. Concurrent executions of an identical query are deduplicated so that only one of them
+// is sent to Livestatus. A cached client is safe for concurrent use, requests being serialized on the
+// underlying client.
+//
+// Cached responses are shared between callers and must not be modified, with the exception of their records
+// order (e.g. using Response.Sort).
+type CachedClient struct {
+	client *Client
+	opts   CacheOptions
+
+	execMu sync.Mutex
+
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List
+	size     int
+	inflight map[string]*cacheCall
+
+	// Invalidation generations, preventing queries running while their table is invalidated from caching stale
+	// responses
+	gen  uint64
+	gens map[string]uint64
+}
+
+type cacheEntry struct {
+	key     string
+	table   string
+	resp    *Response
+	size    int
+	expires time.Time
+}
+
+type cacheCall struct {
+	table string
+
+	wg   sync.WaitGroup
+	resp *Response
+	err  error
+}
+
+// NewCachedClient creates a new cached Livestatus client on top of an existing client.
+func NewCachedClient(c *Client, opts CacheOptions) *CachedClient {
+	return &CachedClient{
+		client:   c,
+		opts:     opts,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+		inflight: map[string]*cacheCall{},
+		gens:     map[string]uint64{},
+	}
+}
+
+// Close closes the underlying client.
+func (c *CachedClient) Close() {
+	c.execMu.Lock()
+	defer c.execMu.Unlock()
+
+	c.client.Close()
+}
+
+// Exec executes a given Livestatus request, using the default TTL for queries.
+func (c *CachedClient) Exec(r Request) (*Response, error) {
+	switch r := r.(type) {
+	case *Query:
+		return c.ExecTTL(r, c.opts.TTL)
+
+	case Query:
+		return c.ExecTTL(&r, c.opts.TTL)
+
+	case *Command:
+		return c.execCommand(r)
+
+	case Command:
+		return c.execCommand(&r)
+	}
+
+	resp, err := c.exec(r)
+	c.Purge()
+
+	return resp, err
+}
+
+// ExecTTL executes a given Livestatus query, keeping its response in cache for a specific duration. A TTL
+// lower or equal to 0 disables caching for this query, while still deduplicating concurrent executions.
+func (c *CachedClient) ExecTTL(q *Query, ttl time.Duration) (*Response, error) {
+	// Render the query at a fixed time, preventing Localtime headers from altering the key
+	key := q.render(time.Time{})
+
+	c.mu.Lock()
+
+	if e, ok := c.entries[key]; ok {
+		entry := e.Value.(*cacheEntry)
+		if c.client.clock.Now().Before(entry.expires) {
+			c.lru.MoveToFront(e)
+			c.mu.Unlock()
+			return entry.resp.shallowCopy(), nil
+		}
+
+		c.remove(e)
+	}
+
+	if call, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+		call.wg.Wait()
+
+		if call.err != nil {
+			return call.resp, call.err
+		}
+		return call.resp.shallowCopy(), nil
+	}
+
+	call := &cacheCall{table: q.table}
+	call.wg.Add(1)
+	c.inflight[key] = call
+
+	gen, tableGen := c.gen, c.gens[q.table]
+
+	c.mu.Unlock()
+
+	call.resp, call.err = c.exec(q)
+
+	c.mu.Lock()
+	if c.inflight[key] == call {
+		delete(c.inflight, key)
+	}
+	// Don't cache the response if the cache has been invalidated while the query was running
+	if call.err == nil && ttl > 0 && c.gen == gen && c.gens[q.table] == tableGen {
+		c.add(key, q.table, call.resp, ttl)
+	}
+	c.mu.Unlock()
+
+	call.wg.Done()
+
+	if call.err != nil {
+		return call.resp, call.err
+	}
+	return call.resp.shallowCopy(), nil
+}
+
+// Invalidate removes the cached response of a given query. The response of the query if currently running won't
+// be cached.
+func (c *CachedClient) Invalidate(q *Query) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := q.render(time.Time{})
+
+	if e, ok := c.entries[key]; ok {
+		c.remove(e)
+	}
+
+	if _, ok := c.inflight[key]; ok {
+		delete(c.inflight, key)
+		c.gens[q.table]++
+	}
+}
+
+// InvalidateTable removes the cached responses of all the queries on a given table. The responses of the queries
+// on this table currently running won't be cached.
+func (c *CachedClient) InvalidateTable(table string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, e := range c.entries {
+		if e.Value.(*cacheEntry).table == table {
+			c.remove(e)
+		}
+	}
+
+	for key, call := range c.inflight {
+		if call.table == table {
+			delete(c.inflight, key)
+		}
+	}
+
+	c.gens[table]++
+}
+
+// Purge removes all the cached responses. The responses of the queries currently running won't be cached.
+func (c *CachedClient) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+	c.size = 0
+
+	c.inflight = map[string]*cacheCall{}
+	c.gen++
+}
+
+// Size returns the approximate memory size in bytes of the cached responses.
+func (c *CachedClient) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+func (c *CachedClient) exec(r Request) (*Response, error) {
+	c.execMu.Lock()
+	defer c.execMu.Unlock()
+
+	return c.client.Exec(r)
+}
+
+func (c *CachedClient) execCommand(cmd *Command) (*Response, error) {
+	resp, err := c.exec(cmd)
+	if err != nil {
+		return resp, err
+	}
+
+	if c.opts.Invalidate == nil {
+		c.Purge()
+	} else {
+		for _, table := range c.opts.Invalidate(cmd) {
+			c.InvalidateTable(table)
+		}
+	}
+
+	return resp, nil
+}
+
+func (c *CachedClient) add(key, table string, resp *Response, ttl time.Duration) {
+	entry := &cacheEntry{
+		key:     key,
+		table:   table,
+		resp:    resp,
+		size:    len(key) + resp.size(),
+		expires: c.client.clock.Now().Add(ttl),
+	}
+
+	if c.opts.MaxSize > 0 && entry.size > c.opts.MaxSize {
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+	c.size += entry.size
+
+	// Evict least recently used entries until getting back under the size limit
+	for c.opts.MaxSize > 0 && c.size > c.opts.MaxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *CachedClient) remove(e *list.Element) {
+	entry := e.Value.(*cacheEntry)
+
+	c.lru.Remove(e)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+func (r *Response) shallowCopy() *Response {
+	if r == nil {
+		return nil
+	}
+
+	out := *r
+	out.Records = append([]Record(nil), r.Records...)
+
+	return &out
+}
+
+func (r *Response) size() int {
+	if r == nil {
+		return 0
+	}
+
+	n := len(r.Message)
+	for _, record := range r.Records {
+		for k, v := range record {
+			n += len(k) + valueSize(v)
+		}
+	}
+
+	return n
+}
+
+func valueSize(v interface{}) int {
+	switch v := v.(type) {
+	case string:
+		return 16 + len(v)
+
+	case []interface{}:
+		n := 24
+		for _, item := range v {
+			n += valueSize(item)
+		}
+		return n
+
+	case map[string]interface{}:
+		n := 48
+		for k, item := range v {
+			n += 16 + len(k) + valueSize(item)
+		}
+		return n
+	}
+
+	return 16
+}
@@ -0,0 +1,205 @@
+package livestatus
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestCachedClient(t *testing.T, opts CacheOptions) (*CachedClient, *int32, func()) {
+	var count int32
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		if strings.HasPrefix(req, "GET ") {
+			atomic.AddInt32(&count, 1)
+			time.Sleep(10 * time.Millisecond)
+		}
+		return 200, `[["name1",123]]`
+	})
+
+	c := NewCachedClient(NewClient("tcp", addr), opts)
+
+	return c, &count, func() {
+		c.Close()
+		stop()
+	}
+}
+
+func Test_CachedClient(t *testing.T) {
+	c, count, stop := newTestCachedClient(t, CacheOptions{TTL: time.Minute})
+	defer stop()
+
+	for i := 0; i < 3; i++ {
+		resp, err := c.Exec(NewQuery("table1").Columns("name", "value"))
+		if err != nil {
+			t.Fatal(err)
+		} else if resp.Len() != 1 {
+			t.Logf("\nExpected 1\nbut got  %d\n", resp.Len())
+			t.Fail()
+		}
+	}
+
+	// Different headers must not share cache entries
+	if _, err := c.Exec(NewQuery("table1").Columns("name", "value").AuthUser("user1")); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := atomic.LoadInt32(count); n != 2 {
+		t.Logf("\nExpected 2 queries\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+func Test_CachedClientInflight(t *testing.T) {
+	c, count, stop := newTestCachedClient(t, CacheOptions{})
+	defer stop()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Exec(NewQuery("table1").Columns("name", "value")); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(count); n < 1 || n > 2 {
+		t.Logf("\nExpected deduplicated queries\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+func Test_CachedClientExpiry(t *testing.T) {
+	c, count, stop := newTestCachedClient(t, CacheOptions{TTL: time.Minute})
+	defer stop()
+
+	q := NewQuery("table1").Columns("name", "value")
+
+	for _, ttl := range []time.Duration{time.Millisecond, time.Minute} {
+		if _, err := c.ExecTTL(q, ttl); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if _, err := c.Exec(q); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := atomic.LoadInt32(count); n != 2 {
+		t.Logf("\nExpected 2 queries\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+func Test_CachedClientInvalidate(t *testing.T) {
+	c, count, stop := newTestCachedClient(t, CacheOptions{
+		TTL: time.Minute,
+		Invalidate: func(cmd *Command) []string {
+			return []string{"table2"}
+		},
+	})
+	defer stop()
+
+	q1 := NewQuery("table1").Columns("name", "value")
+	q2 := NewQuery("table2").Columns("name", "value")
+
+	for _, q := range []*Query{q1, q2} {
+		if _, err := c.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := c.Exec(NewCommand("command1")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, q := range []*Query{q1, q2} {
+		if _, err := c.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := atomic.LoadInt32(count); n != 3 {
+		t.Logf("\nExpected 3 queries\nbut got  %d\n", n)
+		t.Fail()
+	}
+
+	c.Invalidate(q1)
+
+	if _, err := c.Exec(q1); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := atomic.LoadInt32(count); n != 4 {
+		t.Logf("\nExpected 4 queries\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+func Test_CachedClientInvalidateInflight(t *testing.T) {
+	var count int32
+
+	received := make(chan struct{})
+	release := make(chan struct{})
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		if atomic.AddInt32(&count, 1) == 1 {
+			received <- struct{}{}
+			<-release
+		}
+		return 200, `[["name1",123]]`
+	})
+	defer stop()
+
+	c := NewCachedClient(NewClient("tcp", addr), CacheOptions{TTL: time.Minute})
+	defer c.Close()
+
+	q := NewQuery("table1").Columns("name", "value")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, err := c.Exec(q); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// Invalidate the table while the query is running, its response having to be discarded
+	<-received
+	c.InvalidateTable("table1")
+	close(release)
+	<-done
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := atomic.LoadInt32(&count); n != 2 {
+		t.Logf("\nExpected 2 queries\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+func Test_CachedClientMaxSize(t *testing.T) {
+	c, _, stop := newTestCachedClient(t, CacheOptions{TTL: time.Minute, MaxSize: 200})
+	defer stop()
+
+	for _, table := range []string{"table1", "table2", "table3"} {
+		if _, err := c.Exec(NewQuery(table).Columns("name", "value")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if size := c.Size(); size == 0 || size > 200 {
+		t.Logf("\nExpected size within bounds\nbut got  %d\n", size)
+		t.Fail()
+	}
+}
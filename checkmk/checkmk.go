@@ -0,0 +1,5 @@
+// Package checkmk provides the Checkmk specific external commands, the common ones being provided by the nagios
+// package.
+package checkmk
+
+//go:generate go run ../nagios/internal/generate-commands/main.go -spec commands.json -o commands.go -test commands_test.go
@@ -0,0 +1,158 @@
+// Generated by go generate; DO NOT EDIT.
+
+package checkmk
+
+import (
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+	"github.com/vbatoufflet/go-livestatus/nagios"
+)
+
+// AcknowledgeHostProblem creates a new "ACKNOWLEDGE_HOST_PROBLEM" Checkmk command.
+//
+// Allows you to acknowledge the current problem for the specified host.
+//
+// By acknowledging the current problem, future notifications (for the same host state) are disabled.
+//
+// If the "sticky" option is set to two (2), the acknowledgement will remain until the host returns to an UP state.
+//
+// Otherwise the acknowledgement will automatically be removed when the host changes state.
+//
+// If the "notify" option is set to one (1), a notification will be sent out to contacts indicating that the current
+// host problem has been acknowledged.
+//
+// If the "persistent" option is set to one (1), the comment associated with the acknowledgement will survive across
+// restarts of the Nagios process.
+//
+// If not, the comment will be deleted the next time Nagios restarts.
+//
+// The acknowledgement is automatically removed at the time specified by the "expire_time" option, specified in time_t
+// format (seconds since the UNIX epoch), unless it is zero.
+//
+// Supported by Checkmk Microcore.
+func AcknowledgeHostProblem(
+	host_name string,
+	sticky nagios.AckSticky,
+	notify bool,
+	persistent bool,
+	author string,
+	comment string,
+	expire_time time.Time,
+) *livestatus.Command {
+	cmd := livestatus.NewCommand("ACKNOWLEDGE_HOST_PROBLEM").
+		RequiredArg(host_name).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(author).
+		Arg(comment)
+
+	if !expire_time.IsZero() {
+		cmd.Arg(expire_time)
+	}
+
+	return cmd
+}
+
+// AcknowledgeSvcProblem creates a new "ACKNOWLEDGE_SVC_PROBLEM" Checkmk command.
+//
+// Allows you to acknowledge the current problem for the specified service.
+//
+// By acknowledging the current problem, future notifications (for the same servicestate) are disabled.
+//
+// If the "sticky" option is set to two (2), the acknowledgement will remain until the service returns to an OK state.
+//
+// Otherwise the acknowledgement will automatically be removed when the service changes state.
+//
+// If the "notify" option is set to one (1), a notification will be sent out to contacts indicating that the current
+// service problem has been acknowledged.
+//
+// If the "persistent" option is set to one (1), the comment associated with the acknowledgement will survive across
+// restarts of the Nagios process.
+//
+// If not, the comment will be deleted the next time Nagios restarts.
+//
+// The acknowledgement is automatically removed at the time specified by the "expire_time" option, specified in time_t
+// format (seconds since the UNIX epoch), unless it is zero.
+//
+// Supported by Checkmk Microcore.
+func AcknowledgeSvcProblem(
+	host_name string,
+	service_description string,
+	sticky nagios.AckSticky,
+	notify bool,
+	persistent bool,
+	author string,
+	comment string,
+	expire_time time.Time,
+) *livestatus.Command {
+	cmd := livestatus.NewCommand("ACKNOWLEDGE_SVC_PROBLEM").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(author).
+		Arg(comment)
+
+	if !expire_time.IsZero() {
+		cmd.Arg(expire_time)
+	}
+
+	return cmd
+}
+
+// MkLogwatchAcknowledge creates a new "MK_LOGWATCH_ACKNOWLEDGE" Checkmk command.
+//
+// Acknowledges the messages of a logwatch log file of a particular host, the corresponding file being removed from the
+// logwatch spool directory.
+//
+// The "file_name" option is the name of the log file as reported by the logwatch check.
+//
+// This command is handled by the Checkmk Livestatus implementation itself, thus it is available regardless of the
+// monitoring core in use.
+func MkLogwatchAcknowledge(
+	host_name string,
+	file_name string,
+) *livestatus.Command {
+	return livestatus.NewCommand("MK_LOGWATCH_ACKNOWLEDGE").
+		RequiredArg(host_name).
+		RequiredArg(file_name)
+}
+
+// Commands is the catalog of the Checkmk commands, used to parse commands text representations.
+var Commands = nagios.Catalog{
+	"ACKNOWLEDGE_HOST_PROBLEM": {
+		Name: "ACKNOWLEDGE_HOST_PROBLEM",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+			{Name: "sticky", Type: nagios.ArgAckSticky},
+			{Name: "notify", Type: nagios.ArgBool},
+			{Name: "persistent", Type: nagios.ArgBool},
+			{Name: "author", Type: nagios.ArgString},
+			{Name: "comment", Type: nagios.ArgString},
+			{Name: "expire_time", Type: nagios.ArgTime, Optional: true},
+		},
+	},
+	"ACKNOWLEDGE_SVC_PROBLEM": {
+		Name: "ACKNOWLEDGE_SVC_PROBLEM",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+			{Name: "service_description", Type: nagios.ArgString, Required: true},
+			{Name: "sticky", Type: nagios.ArgAckSticky},
+			{Name: "notify", Type: nagios.ArgBool},
+			{Name: "persistent", Type: nagios.ArgBool},
+			{Name: "author", Type: nagios.ArgString},
+			{Name: "comment", Type: nagios.ArgString},
+			{Name: "expire_time", Type: nagios.ArgTime, Optional: true},
+		},
+	},
+	"MK_LOGWATCH_ACKNOWLEDGE": {
+		Name: "MK_LOGWATCH_ACKNOWLEDGE",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+			{Name: "file_name", Type: nagios.ArgString, Required: true},
+		},
+	},
+}
@@ -0,0 +1,55 @@
+// Generated by go generate; DO NOT EDIT.
+
+package checkmk
+
+import (
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+	"github.com/vbatoufflet/go-livestatus/nagios"
+)
+
+func Test_Commands(t *testing.T) {
+	for _, test := range []struct {
+		cmd      *livestatus.Command
+		expected string
+	}{
+		{
+			AcknowledgeHostProblem("host_name", nagios.AckStickyUntilOK, true, true, "author", "comment", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] ACKNOWLEDGE_HOST_PROBLEM;host_name;2;1;1;author;comment;1439633040\n\n",
+		},
+		{
+			AcknowledgeHostProblem("host_name", nagios.AckStickyUntilOK, true, true, "author", "comment", time.Time{}),
+			"COMMAND [1439633040] ACKNOWLEDGE_HOST_PROBLEM;host_name;2;1;1;author;comment\n\n",
+		},
+		{
+			AcknowledgeSvcProblem("host_name", "service_description", nagios.AckStickyUntilOK, true, true, "author", "comment", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;host_name;service_description;2;1;1;author;comment;1439633040\n\n",
+		},
+		{
+			AcknowledgeSvcProblem("host_name", "service_description", nagios.AckStickyUntilOK, true, true, "author", "comment", time.Time{}),
+			"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;host_name;service_description;2;1;1;author;comment\n\n",
+		},
+		{
+			MkLogwatchAcknowledge("host_name", "file_name"),
+			"COMMAND [1439633040] MK_LOGWATCH_ACKNOWLEDGE;host_name;file_name\n\n",
+		},
+	} {
+		result := test.cmd.Timestamp(time.Unix(1439633040, 0)).String()
+
+		if err := test.cmd.Err(); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if result != test.expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		} else if pc, err := Commands.Parse(result); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if rt := pc.Command().String(); rt != result {
+			t.Logf("\nExpected %q\nbut got  %q\n", result, rt)
+			t.Fail()
+		}
+	}
+}
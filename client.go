@@ -2,6 +2,7 @@ package livestatus
 
 import (
 	"net"
+	"sync"
 )
 
 // Client represents a Livestatus client instance.
@@ -9,7 +10,10 @@ type Client struct {
 	network string
 	address string
 	dialer  *net.Dialer
-	conn    net.Conn
+	clock   Clock
+
+	mu   sync.Mutex
+	conn net.Conn
 }
 
 // NewClient creates a new Livestatus client instance.
@@ -23,11 +27,34 @@ func NewClientWithDialer(network, address string, dialer *net.Dialer) *Client {
 		network: network,
 		address: address,
 		dialer:  dialer,
+		clock:   SystemClock,
 	}
 }
 
-// Close closes any remaining connection.
+// SetClock sets the clock used for commands timestamps, Localtime headers, default log time windows and cached
+// responses expiry. Network and wait timeouts are measured using the system clock.
+func (c *Client) SetClock(clock Clock) {
+	c.clock = clock
+}
+
+// Clock returns the clock used by the client.
+func (c *Client) Clock() Clock {
+	return c.clock
+}
+
+// clone returns a new client sharing the client settings, using its own connection.
+func (c *Client) clone() *Client {
+	clone := NewClientWithDialer(c.network, c.address, c.dialer)
+	clone.clock = c.clock
+
+	return clone
+}
+
+// Close closes any remaining connection. It can be called concurrently with Exec to interrupt a pending request.
 func (c *Client) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.conn != nil {
 		c.conn.Close()
 		c.conn = nil
@@ -38,10 +65,14 @@ func (c *Client) Close() {
 func (c *Client) Exec(r Request) (*Response, error) {
 	var err error
 
+	c.mu.Lock()
+
 	// Initialize connection if none available
 	if c.conn == nil {
 		c.conn, err = c.dialer.Dial(c.network, c.address)
 		if err != nil {
+			c.conn = nil
+			c.mu.Unlock()
 			return nil, err
 		}
 
@@ -50,10 +81,17 @@ func (c *Client) Exec(r Request) (*Response, error) {
 			case "tcp":
 				c.conn.(*net.TCPConn).SetKeepAlive(true)
 			}
-		} else {
-			defer c.Close()
 		}
 	}
 
-	return r.handle(c.conn)
+	conn := c.conn
+
+	c.mu.Unlock()
+
+	// Livestatus closes the connection after non keep-alive requests, even when reusing an existing one
+	if !r.keepAlive() {
+		defer c.Close()
+	}
+
+	return r.handle(conn, c.clock)
 }
@@ -0,0 +1,168 @@
+package livestatus
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testHandler func(req string) (status int, body string)
+
+func newTestServer(t *testing.T, handler testHandler) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go serveTestConn(conn, handler)
+		}
+	}()
+
+	return l.Addr().String(), func() { l.Close() }
+}
+
+func serveTestConn(conn net.Conn, handler testHandler) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	for {
+		req := ""
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			req += line
+			if line == "\n" {
+				break
+			}
+		}
+
+		if strings.HasPrefix(req, "COMMAND ") {
+			handler(req)
+			continue
+		}
+
+		status, body := handler(req)
+		fmt.Fprintf(conn, "%3d %11d\n%s", status, len(body), body)
+
+		if !strings.Contains(req, "KeepAlive: on\n") {
+			return
+		}
+	}
+}
+
+func Test_ClientExec(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		return 200, `[["name1",123]]`
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	resp, err := c.Exec(NewQuery("table1").Columns("name", "value"))
+	if err != nil {
+		t.Fatal(err)
+	} else if resp.Len() != 1 {
+		t.Logf("\nExpected 1\nbut got  %d\n", resp.Len())
+		t.Fail()
+	}
+}
+
+func Test_ClientExecReuse(t *testing.T) {
+	var conns int32
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&conns, 1)
+
+			go serveTestConn(conn, func(req string) (int, string) {
+				return 200, `[["name1"]]`
+			})
+		}
+	}()
+
+	c := NewClient("tcp", l.Addr().String())
+	defer c.Close()
+
+	// Livestatus closes the connection reused by the non keep-alive query, thus the last query needs a new one
+	for _, q := range []*Query{
+		NewQuery("table1").Columns("name").KeepAlive(),
+		NewQuery("table1").Columns("name"),
+		NewQuery("table1").Columns("name"),
+	} {
+		if _, err := c.Exec(q); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.FailNow()
+		}
+	}
+
+	if n := atomic.LoadInt32(&conns); n != 2 {
+		t.Logf("\nExpected 2\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c fakeClock) Now() time.Time {
+	return c.now
+}
+
+func Test_ClientClock(t *testing.T) {
+	requests := make(chan string, 3)
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		requests <- req
+		return 200, "[]"
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633040, 0)})
+	defer c.Close()
+
+	if _, err := c.Exec(NewCommand("command1")); err != nil {
+		t.Fatal(err)
+	} else if _, err := c.Exec(NewCommand("command2").Timestamp(time.Unix(1439600000, 0))); err != nil {
+		t.Fatal(err)
+	} else if _, err := c.Exec(NewQuery("table1").Localtime()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"COMMAND [1439633040] command1\n\n",
+		"COMMAND [1439600000] command2\n\n",
+		"GET table1\nLocaltime: 1439633040\nResponseHeader: fixed16\nOutputFormat: json\n\n",
+	} {
+		if result := <-requests; result != expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+			t.Fail()
+		}
+	}
+}
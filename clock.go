@@ -0,0 +1,17 @@
+package livestatus
+
+import "time"
+
+// Clock represents a source of current time, allowing the library to run on a fake clock (e.g. in tests).
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the clock relying on the system time.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
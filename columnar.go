@@ -0,0 +1,537 @@
+package livestatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type cellKind uint8
+
+const (
+	cellNull cellKind = iota
+	cellBool
+	cellInt
+	cellFloat
+	cellString
+	cellRaw
+)
+
+// cell represents a single decoded value. Numbers and booleans are stored in n (floats using their IEEE 754
+// binary representation), strings and raw nested JSON values in s.
+type cell struct {
+	s    string
+	n    uint64
+	kind cellKind
+}
+
+func (c cell) value() interface{} {
+	switch c.kind {
+	case cellBool:
+		return c.n != 0
+	case cellInt:
+		return int64(c.n)
+	case cellFloat:
+		return math.Float64frombits(c.n)
+	case cellString:
+		return c.s
+	case cellRaw:
+		var v interface{}
+
+		dec := json.NewDecoder(strings.NewReader(c.s))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			return nil
+		}
+		return decodeNumbers(v)
+	}
+
+	return nil
+}
+
+func (c cell) jsonType() string {
+	switch c.kind {
+	case cellBool:
+		return "boolean"
+	case cellInt, cellFloat:
+		return "number"
+	case cellString:
+		return "string"
+	case cellRaw:
+		if strings.HasPrefix(c.s, "{") {
+			return "object"
+		}
+		return "array"
+	}
+
+	return "null"
+}
+
+// ColumnarResponse represents a Livestatus query response stored by columns and rows, values being kept in a
+// compact typed form. Records are only built when requested, making it suitable for large responses.
+type ColumnarResponse struct {
+	Status    int
+	Message   string
+	Columns   []string
+	Truncated bool
+
+	cells []cell
+	rows  int
+}
+
+// Len returns the number of rows present in the response.
+func (r *ColumnarResponse) Len() int {
+	return r.rows
+}
+
+// ColumnIndex returns the index of a given column, or -1 if the column is not present.
+func (r *ColumnarResponse) ColumnIndex(name string) int {
+	for i, c := range r.Columns {
+		if c == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Record returns a record view of a given row. It panics if the row index is out of range.
+func (r *ColumnarResponse) Record(row int) Record {
+	rec := make(Record, len(r.Columns))
+	for i, c := range r.Columns {
+		rec[c] = r.cell(row, i).value()
+	}
+
+	return rec
+}
+
+// Records returns the record views of all the rows.
+func (r *ColumnarResponse) Records() []Record {
+	records := make([]Record, r.rows)
+	for i := range records {
+		records[i] = r.Record(i)
+	}
+
+	return records
+}
+
+// Response returns a regular response containing the record views of all the rows.
+func (r *ColumnarResponse) Response() *Response {
+	return &Response{
+		Status:    r.Status,
+		Message:   r.Message,
+		Records:   r.Records(),
+		Columns:   r.Columns,
+		Truncated: r.Truncated,
+	}
+}
+
+// Value returns an interface value for a specific row and column. It panics if the row or column index is out of
+// range.
+func (r *ColumnarResponse) Value(row, col int) interface{} {
+	return r.cell(row, col).value()
+}
+
+// Bool returns a boolean value for a specific row and column.
+func (r *ColumnarResponse) Bool(row, col int) (bool, error) {
+	if err := r.checkIndex(row, col); err != nil {
+		return false, err
+	}
+
+	c := r.cell(row, col)
+	switch c.kind {
+	case cellInt, cellBool:
+		return c.n != 0, nil
+	}
+
+	return false, r.typeError(row, col, "number")
+}
+
+// Int returns an integer value for a specific row and column.
+func (r *ColumnarResponse) Int(row, col int) (int64, error) {
+	if err := r.checkIndex(row, col); err != nil {
+		return 0, err
+	}
+
+	c := r.cell(row, col)
+	switch c.kind {
+	case cellInt:
+		return int64(c.n), nil
+	case cellFloat:
+		if f := math.Float64frombits(c.n); f == math.Trunc(f) {
+			return int64(f), nil
+		}
+	}
+
+	return 0, r.typeError(row, col, "integer")
+}
+
+// Float returns a float value for a specific row and column.
+func (r *ColumnarResponse) Float(row, col int) (float64, error) {
+	if err := r.checkIndex(row, col); err != nil {
+		return 0, err
+	}
+
+	c := r.cell(row, col)
+	switch c.kind {
+	case cellInt:
+		return float64(int64(c.n)), nil
+	case cellFloat:
+		return math.Float64frombits(c.n), nil
+	}
+
+	return 0, r.typeError(row, col, "number")
+}
+
+// String returns a string value for a specific row and column.
+func (r *ColumnarResponse) String(row, col int) (string, error) {
+	if err := r.checkIndex(row, col); err != nil {
+		return "", err
+	}
+
+	c := r.cell(row, col)
+	if c.kind != cellString {
+		return "", r.typeError(row, col, "string")
+	}
+
+	return c.s, nil
+}
+
+// Time returns a time struct value for a specific row and column.
+func (r *ColumnarResponse) Time(row, col int) (time.Time, error) {
+	v, err := r.Int(row, col)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(v, 0), nil
+}
+
+// checkIndex returns an error if a given row or column index is out of range, the typed accessors reporting errors
+// instead of panicking.
+func (r *ColumnarResponse) checkIndex(row, col int) error {
+	if col < 0 || col >= len(r.Columns) {
+		return fmt.Errorf("row %d: %w", row, ColumnError{Column: fmt.Sprintf("#%d", col), Err: ErrUnknownColumn})
+	} else if row < 0 || row >= r.rows {
+		return fmt.Errorf("row %d: %w", row, ErrRowOutOfRange)
+	}
+
+	return nil
+}
+
+func (r *ColumnarResponse) cell(row, col int) cell {
+	return r.cells[row*len(r.Columns)+col]
+}
+
+func (r *ColumnarResponse) typeError(row, col int, expected string) error {
+	c := r.cell(row, col)
+
+	return fmt.Errorf("row %d: %w", row, ColumnError{
+		Column:   r.Columns[col],
+		Expected: expected,
+		Actual:   c.jsonType(),
+		Value:    c.value(),
+		Err:      ErrInvalidType,
+	})
+}
+
+// ExecColumnar executes a given Livestatus query, decoding its response in columnar form.
+func (c *Client) ExecColumnar(q *Query) (*ColumnarResponse, error) {
+	r := &columnarRequest{Query: q}
+
+	_, err := c.Exec(r)
+
+	return r.resp, err
+}
+
+type columnarRequest struct {
+	*Query
+	resp *ColumnarResponse
+}
+
+func (r *columnarRequest) handle(conn net.Conn, clock Clock) (*Response, error) {
+	resp, body, err := r.Query.roundTrip(conn, clock)
+	if resp != nil {
+		r.resp = &ColumnarResponse{
+			Status:  resp.Status,
+			Message: resp.Message,
+			Columns: r.Query.columns,
+		}
+	}
+
+	if err != nil || len(body) == 0 {
+		return nil, err
+	}
+
+	r.resp, err = decodeColumnar(body, r.Query.columns)
+	if err != nil {
+		return nil, fmt.Errorf("parsing read data as records failed: %v", err)
+	}
+	r.resp.Status = resp.Status
+	r.resp.Truncated = r.Query.limit > 0 && r.resp.rows >= r.Query.limit
+
+	return nil, nil
+}
+
+// decodeColumnar decodes Livestatus JSON output (an array of rows, each row being an array of values) into a
+// columnar response. If no columns are given, they are read from the first row.
+func decodeColumnar(data []byte, columns []string) (*ColumnarResponse, error) {
+	d := columnarDecoder{data: string(data)}
+
+	resp := &ColumnarResponse{Columns: columns}
+
+	if err := d.expect('['); err != nil {
+		return nil, err
+	}
+
+	for first := true; ; first = false {
+		d.skipSpaces()
+		if d.peek() == ']' {
+			d.pos++
+			break
+		} else if !first {
+			if err := d.expect(','); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(resp.Columns) == 0 {
+			header, err := d.row(nil)
+			if err != nil {
+				return nil, err
+			}
+
+			resp.Columns = make([]string, len(header))
+			for i, c := range header {
+				if c.kind != cellString {
+					return nil, d.error("invalid column name")
+				}
+				resp.Columns[i] = c.s
+			}
+
+			if resp.cells == nil {
+				resp.cells = make([]cell, 0, len(data)/16)
+			}
+			continue
+		}
+
+		if resp.cells == nil {
+			resp.cells = make([]cell, 0, len(data)/16)
+		}
+
+		n := len(resp.cells)
+
+		var err error
+		resp.cells, err = d.row(resp.cells)
+		if err != nil {
+			return nil, err
+		} else if len(resp.cells)-n != len(resp.Columns) {
+			return nil, d.error(fmt.Sprintf("expected %d values but got %d", len(resp.Columns), len(resp.cells)-n))
+		}
+
+		resp.rows++
+	}
+
+	if d.skipSpaces(); d.pos != len(d.data) {
+		return nil, d.error("unexpected trailing data")
+	}
+
+	return resp, nil
+}
+
+type columnarDecoder struct {
+	data string
+	pos  int
+}
+
+func (d *columnarDecoder) row(cells []cell) ([]cell, error) {
+	if err := d.expect('['); err != nil {
+		return nil, err
+	}
+
+	for first := true; ; first = false {
+		d.skipSpaces()
+		if d.peek() == ']' {
+			d.pos++
+			return cells, nil
+		} else if !first {
+			if err := d.expect(','); err != nil {
+				return nil, err
+			}
+		}
+
+		c, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		cells = append(cells, c)
+	}
+}
+
+func (d *columnarDecoder) value() (cell, error) {
+	d.skipSpaces()
+
+	switch ch := d.peek(); {
+	case ch == '"':
+		return d.string()
+
+	case ch == '-' || ch >= '0' && ch <= '9':
+		return d.number()
+
+	case ch == '[' || ch == '{':
+		start := d.pos
+		if err := d.skipNested(); err != nil {
+			return cell{}, err
+		}
+		return cell{kind: cellRaw, s: d.data[start:d.pos]}, nil
+
+	case strings.HasPrefix(d.data[d.pos:], "true"):
+		d.pos += 4
+		return cell{kind: cellBool, n: 1}, nil
+
+	case strings.HasPrefix(d.data[d.pos:], "false"):
+		d.pos += 5
+		return cell{kind: cellBool}, nil
+
+	case strings.HasPrefix(d.data[d.pos:], "null"):
+		d.pos += 4
+		return cell{kind: cellNull}, nil
+	}
+
+	return cell{}, d.error("unexpected character")
+}
+
+func (d *columnarDecoder) string() (cell, error) {
+	start := d.pos
+	escaped := false
+
+	for d.pos++; d.pos < len(d.data); d.pos++ {
+		switch d.data[d.pos] {
+		case '\\':
+			escaped = true
+			d.pos++
+
+		case '"':
+			d.pos++
+
+			if !escaped {
+				return cell{kind: cellString, s: d.data[start+1 : d.pos-1]}, nil
+			}
+
+			var s string
+			if err := json.Unmarshal([]byte(d.data[start:d.pos]), &s); err != nil {
+				return cell{}, d.error(err.Error())
+			}
+			return cell{kind: cellString, s: s}, nil
+		}
+	}
+
+	return cell{}, d.error("unterminated string")
+}
+
+func (d *columnarDecoder) number() (cell, error) {
+	start := d.pos
+	isFloat := false
+
+loop:
+	for ; d.pos < len(d.data); d.pos++ {
+		switch d.data[d.pos] {
+		case '.', 'e', 'E':
+			isFloat = true
+		case '-', '+', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		default:
+			break loop
+		}
+	}
+
+	s := d.data[start:d.pos]
+
+	if !isFloat {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return cell{kind: cellInt, n: uint64(n)}, nil
+		}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return cell{}, d.error(fmt.Sprintf("invalid number %q", s))
+	}
+
+	return cell{kind: cellFloat, n: math.Float64bits(f)}, nil
+}
+
+func (d *columnarDecoder) skipNested() error {
+	depth := 0
+
+	for ; d.pos < len(d.data); d.pos++ {
+		switch d.data[d.pos] {
+		case '[', '{':
+			depth++
+
+		case ']', '}':
+			depth--
+			if depth == 0 {
+				d.pos++
+				return nil
+			}
+
+		case '"':
+			for d.pos++; d.pos < len(d.data) && d.data[d.pos] != '"'; d.pos++ {
+				if d.data[d.pos] == '\\' {
+					d.pos++
+				}
+			}
+		}
+	}
+
+	return d.error("unterminated nested value")
+}
+
+func (d *columnarDecoder) expect(ch byte) error {
+	if d.skipSpaces(); d.peek() != ch {
+		return d.error(fmt.Sprintf("expected %q", ch))
+	}
+	d.pos++
+
+	return nil
+}
+
+func (d *columnarDecoder) peek() byte {
+	if d.pos >= len(d.data) {
+		return 0
+	}
+
+	return d.data[d.pos]
+}
+
+func (d *columnarDecoder) skipSpaces() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (d *columnarDecoder) error(msg string) error {
+	end := d.pos + 16
+	if end > len(d.data) {
+		end = len(d.data)
+	}
+
+	start := d.pos
+	if start > len(d.data) {
+		start = len(d.data)
+	}
+
+	return ParseError{
+		Message:    fmt.Sprintf("decoding JSON failed at offset %d: %s", d.pos, msg),
+		FailedData: []byte(d.data[start:end]),
+	}
+}
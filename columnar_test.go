@@ -0,0 +1,208 @@
+package livestatus
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_DecodeColumnar(t *testing.T) {
+	data := `[
+		["name", "value", "ratio", "groups", "vars", "escaped", "none"],
+		["name1", 123, 1.5, ["group1", "group2"], {"KEY": "value"}, "a\"bé", null],
+		["name2", -456, 2e3, [], {}, "", null]
+	]`
+
+	resp, err := decodeColumnar([]byte(data), nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if resp.Len() != 2 {
+		t.Fatalf("\nExpected 2 rows\nbut got  %d\n", resp.Len())
+	}
+
+	expected := []Record{
+		{
+			"name":    "name1",
+			"value":   int64(123),
+			"ratio":   1.5,
+			"groups":  []interface{}{"group1", "group2"},
+			"vars":    map[string]interface{}{"KEY": "value"},
+			"escaped": "a\"bé",
+			"none":    nil,
+		},
+		{
+			"name":    "name2",
+			"value":   int64(-456),
+			"ratio":   2000.0,
+			"groups":  []interface{}{},
+			"vars":    map[string]interface{}{},
+			"escaped": "",
+			"none":    nil,
+		},
+	}
+
+	result := resp.Records()
+	if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+
+	// Ensure records are consistent with the regular parsing path
+	_, records, err := NewQuery("table1").parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, records) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", records, result)
+		t.Fail()
+	}
+}
+
+func Test_DecodeColumnarWithColumns(t *testing.T) {
+	resp, err := decodeColumnar([]byte(`[["name1",1439633040,1]]`), []string{"name", "time", "flag"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	col := resp.ColumnIndex("time")
+
+	if s, err := resp.String(0, resp.ColumnIndex("name")); err != nil || s != "name1" {
+		t.Logf("\nExpected name1\nbut got  %#v (%v)\n", s, err)
+		t.Fail()
+	} else if v, err := resp.Time(0, col); err != nil || !v.Equal(time.Unix(1439633040, 0)) {
+		t.Logf("\nExpected %s\nbut got  %s (%v)\n", time.Unix(1439633040, 0), v, err)
+		t.Fail()
+	} else if v, err := resp.Bool(0, resp.ColumnIndex("flag")); err != nil || !v {
+		t.Logf("\nExpected true\nbut got  %#v (%v)\n", v, err)
+		t.Fail()
+	} else if resp.ColumnIndex("unknown") != -1 {
+		t.Logf("\nExpected -1 for unknown column\n")
+		t.Fail()
+	}
+
+	_, err = resp.String(0, col)
+
+	var cerr ColumnError
+	if !errors.As(err, &cerr) || cerr.Column != "time" || cerr.Actual != "number" || !errors.Is(err, ErrInvalidType) {
+		t.Logf("\nUnexpected error %#v\n", err)
+		t.Fail()
+	}
+}
+
+func Test_DecodeColumnarInvalid(t *testing.T) {
+	for _, data := range []string{
+		``,
+		`[["name1"]`,
+		`[["name1" "name2"]]`,
+		`[["name1", 1]]`,
+		`[["name1", tru]]`,
+		`[["name1\"]]`,
+		`[["name1"]] x`,
+	} {
+		if _, err := decodeColumnar([]byte(data), []string{"name"}); err == nil {
+			t.Logf("\nExpected error for %q\nbut got  nil\n", data)
+			t.Fail()
+		}
+	}
+}
+
+func Test_ColumnarIndex(t *testing.T) {
+	r, err := decodeColumnar([]byte(`[["name1",1]]`), []string{"name", "value"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		row, col int
+		expected error
+	}{
+		{0, 2, ErrUnknownColumn},
+		{0, -1, ErrUnknownColumn},
+		{1, 0, ErrRowOutOfRange},
+		{-1, 1, ErrRowOutOfRange},
+	} {
+		if _, err := r.String(test.row, test.col); !errors.Is(err, test.expected) {
+			t.Logf("\nExpected %#v\nbut got  %#v\n", test.expected, err)
+			t.Fail()
+		}
+
+		if _, err := r.Int(test.row, test.col); !errors.Is(err, test.expected) {
+			t.Logf("\nExpected %#v\nbut got  %#v\n", test.expected, err)
+			t.Fail()
+		}
+	}
+}
+
+func Test_ClientExecColumnar(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		return 200, `[["name1",123],["name2",456]]`
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	resp, err := c.ExecColumnar(NewQuery("table1").Columns("name", "value").Limit(2))
+	if err != nil {
+		t.Fatal(err)
+	} else if resp.Status != 200 || resp.Len() != 2 || !resp.Truncated {
+		t.Logf("\nUnexpected response %#v\n", resp)
+		t.Fail()
+	} else if v, err := resp.Int(1, 1); err != nil || v != 456 {
+		t.Logf("\nExpected 456\nbut got  %d (%v)\n", v, err)
+		t.Fail()
+	}
+}
+
+func benchmarkData(rows int) []byte {
+	lines := []string{}
+	for i := 0; i < rows; i++ {
+		lines = append(lines, fmt.Sprintf(`["host%d","service%d",%d,%d,"OK - everything is fine",0.0%d,["group1","group2"]]`,
+			i/10, i%10, i%4, 1439633040+i, i%1000))
+	}
+
+	return []byte("[" + strings.Join(lines, ",\n") + "]")
+}
+
+var benchmarkColumns = []string{
+	"host_name", "description", "state", "last_check", "plugin_output", "execution_time", "groups",
+}
+
+func reportAllocsPerRow(b *testing.B, rows int, fn func()) {
+	var before, after runtime.MemStats
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	runtime.ReadMemStats(&before)
+	for i := 0; i < b.N; i++ {
+		fn()
+	}
+	runtime.ReadMemStats(&after)
+
+	b.ReportMetric(float64(after.Mallocs-before.Mallocs)/float64(b.N*rows), "allocs/row")
+}
+
+func BenchmarkQueryParse(b *testing.B) {
+	data := benchmarkData(10000)
+	q := NewQuery("services").Columns(benchmarkColumns...)
+
+	reportAllocsPerRow(b, 10000, func() {
+		if _, _, err := q.parse(data); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
+
+func BenchmarkDecodeColumnar(b *testing.B) {
+	data := benchmarkData(10000)
+
+	reportAllocsPerRow(b, 10000, func() {
+		if _, err := decodeColumnar(data, benchmarkColumns); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
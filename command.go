@@ -9,8 +9,11 @@ import (
 
 // Command represents a Livestatus command instance.
 type Command struct {
-	name string
-	args []string
+	name      string
+	args      []string
+	required  map[int]struct{}
+	timestamp time.Time
+	err       error
 
 	writeTimeout time.Duration
 }
@@ -23,12 +26,45 @@ func NewCommand(name string, args ...string) *Command {
 	}
 }
 
-// Arg appends a new argument to the command.
+// Arg appends a new argument to the command. Values are formatted as query values (e.g. booleans as 0/1, times
+// as Unix timestamps), unsupported types being reported by Err.
 func (c *Command) Arg(v interface{}) *Command {
-	c.args = append(c.args, fmt.Sprintf("%v", v))
+	s, err := formatValue(v)
+	if err != nil {
+		c.setErr(fmt.Errorf("argument #%d: %w", len(c.args)+1, err))
+	}
+	c.args = append(c.args, s)
+
+	return c
+}
+
+// RequiredArg appends a new argument to the command, Err reporting an error if its value is empty.
+func (c *Command) RequiredArg(v interface{}) *Command {
+	if c.required == nil {
+		c.required = map[int]struct{}{}
+	}
+	c.required[len(c.args)] = struct{}{}
+
+	return c.Arg(v)
+}
+
+// Timestamp sets the time the command is stamped with, instead of the time it is sent at (e.g. to back-date
+// passive check results).
+func (c *Command) Timestamp(t time.Time) *Command {
+	c.timestamp = t
 	return c
 }
 
+// Stamped returns a copy of the command stamped with a given time, unless a timestamp has already been set using
+// Timestamp. The copy renders the same text whenever it is sent.
+func (c Command) Stamped(now time.Time) *Command {
+	if c.timestamp.IsZero() {
+		c.timestamp = now
+	}
+
+	return &c
+}
+
 // WriteTimeout sets the connection timeout for write operations.
 // A value of 0 disables the timeout.
 func (c *Command) WriteTimeout(timeout time.Duration) *Command {
@@ -36,9 +72,45 @@ func (c *Command) WriteTimeout(timeout time.Duration) *Command {
 	return c
 }
 
+// Err returns the first error encountered while building the command, or an error if the command name or one of
+// its arguments contains characters able to alter the command sent to Livestatus. Arguments can't contain
+// control characters, and all but the last one can't contain the ';' field separator.
+func (c Command) Err() error {
+	if c.err != nil {
+		return c.err
+	} else if err := checkValue("command name", c.name); err != nil {
+		return err
+	} else if i := strings.IndexAny(c.name, "; "); i != -1 {
+		return UnsafeValueError{Field: "command name", Value: c.name, Position: i}
+	}
+
+	for i, arg := range c.args {
+		field := fmt.Sprintf("argument #%d", i+1)
+
+		if err := checkValue(field, arg); err != nil {
+			return err
+		} else if j := strings.IndexByte(arg, ';'); j != -1 && i < len(c.args)-1 {
+			return UnsafeValueError{Field: field, Value: arg, Position: j}
+		} else if _, ok := c.required[i]; ok && arg == "" {
+			return fmt.Errorf("%s: %w", field, ErrEmptyArgument)
+		}
+	}
+
+	return nil
+}
+
 // String returns a string representation of the Livestatus command.
 func (c Command) String() string {
-	s := fmt.Sprintf("COMMAND [%d] %s", time.Now().Unix(), c.name)
+	return c.render(time.Now())
+}
+
+// render returns a string representation of the Livestatus command, as sent at a given time.
+func (c Command) render(now time.Time) string {
+	if !c.timestamp.IsZero() {
+		now = c.timestamp
+	}
+
+	s := fmt.Sprintf("COMMAND [%d] %s", now.Unix(), c.name)
 	if len(c.args) > 0 {
 		s += ";" + strings.Join(c.args, ";")
 	}
@@ -47,8 +119,12 @@ func (c Command) String() string {
 	return s
 }
 
-func (c Command) handle(conn net.Conn) (*Response, error) {
-	cmd := c.String()
+func (c Command) handle(conn net.Conn, clock Clock) (*Response, error) {
+	if err := c.Err(); err != nil {
+		return nil, err
+	}
+
+	cmd := c.render(clock.Now())
 	lcmd := len(cmd)
 
 	if c.writeTimeout > 0 {
@@ -71,6 +147,12 @@ func (c Command) handle(conn net.Conn) (*Response, error) {
 	return nil, nil
 }
 
+func (c *Command) setErr(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
 func (c Command) keepAlive() bool {
 	return true
 }
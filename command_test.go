@@ -1,6 +1,7 @@
 package livestatus
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -43,3 +44,117 @@ func Test_CommandWithArgs(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_CommandUnsafeArgs(t *testing.T) {
+	c := NewCommand("command1", "arg1")
+	c.Arg("arg2\nCOMMAND [0] command2")
+
+	err := c.Err()
+	if e, ok := err.(UnsafeValueError); !ok || e.Field != "argument #2" {
+		t.Logf("\nExpected UnsafeValueError\nbut got  %#v\n", err)
+		t.Fail()
+	}
+}
+
+func Test_CommandTimestamp(t *testing.T) {
+	expected := "COMMAND [1439633040] command1;arg1\n\n"
+
+	c := NewCommand("command1", "arg1").Timestamp(time.Unix(1439633040, 0))
+
+	result := c.String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_CommandStamped(t *testing.T) {
+	c := NewCommand("command1", "arg1")
+
+	expected := "COMMAND [1439633040] command1;arg1\n\n"
+	if result := c.Stamped(time.Unix(1439633040, 0)).String(); result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+
+	if !c.timestamp.IsZero() {
+		t.Logf("\nExpected original command not to be stamped\nbut got  %s\n", c.timestamp)
+		t.Fail()
+	}
+
+	expected = "COMMAND [1439600000] command1;arg1\n\n"
+	if result := c.Timestamp(time.Unix(1439600000, 0)).Stamped(time.Unix(1439633040, 0)).String(); result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_CommandArgFormatting(t *testing.T) {
+	expected := "COMMAND [1439633040] command1;1;0;1439600000;300;1.5\n\n"
+
+	c := NewCommand("command1").
+		Arg(true).
+		Arg(false).
+		Arg(time.Unix(1439600000, 0)).
+		Arg(5 * time.Minute).
+		Arg(1.5).
+		Timestamp(time.Unix(1439633040, 0))
+
+	if err := c.Err(); err != nil {
+		t.Fatal(err)
+	} else if result := c.String(); result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+
+	c = NewCommand("command1").Arg(struct{}{})
+	if err := c.Err(); err == nil || err.Error() != "argument #1: unsupported value type struct {}" {
+		t.Logf("\nExpected unsupported value type error\nbut got  %#v\n", err)
+		t.Fail()
+	}
+}
+
+func Test_CommandArgSeparators(t *testing.T) {
+	// Separators are allowed in the last argument only
+	c := NewCommand("command1").Arg("host1").Arg("comment; with separator")
+	if err := c.Err(); err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.Fail()
+	}
+
+	c = NewCommand("command1").Arg("host1;host2").Arg("comment")
+
+	err := c.Err()
+	if e, ok := err.(UnsafeValueError); !ok || e.Field != "argument #1" || e.Position != 5 {
+		t.Logf("\nExpected UnsafeValueError\nbut got  %#v\n", err)
+		t.Fail()
+	} else if expected := `unsafe value for argument #1: character ';' at position 5 in "host1;host2"`; err.Error() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, err.Error())
+		t.Fail()
+	}
+}
+
+func Test_CommandRequiredArg(t *testing.T) {
+	c := NewCommand("command1").RequiredArg("host1").RequiredArg("").Arg("")
+
+	err := c.Err()
+	if !errors.Is(err, ErrEmptyArgument) || err.Error() != "argument #2: empty required argument" {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrEmptyArgument, err)
+		t.Fail()
+	}
+}
+
+func Test_SanitizeComment(t *testing.T) {
+	expected := "line1 line2, with separator  and tab"
+
+	result := SanitizeComment("line1\nline2; with separator\r\tand tab")
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+
+	if err := NewCommand("command1").Arg(SanitizeComment("a;b\nc")).Arg("comment").Err(); err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.Fail()
+	}
+}
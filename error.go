@@ -2,15 +2,33 @@ package livestatus
 
 import (
 	"errors"
+	"fmt"
+	"unicode/utf8"
 )
 
 var (
-	// ErrInvalidQuery represents an invalid query error.
+	// ErrInvalidQuery represents an invalid query error, matched by all the ResponseError values.
 	ErrInvalidQuery = errors.New("invalid query")
+	// ErrBadRequest represents a request containing an invalid header (status 400).
+	ErrBadRequest = errors.New("bad request")
+	// ErrForbidden represents a request the user is not authorized to perform (status 403).
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound represents a request on a table that does not exist (status 404).
+	ErrNotFound = errors.New("not found")
+	// ErrRequestTooLarge represents a request exceeding the size limit (status 413).
+	ErrRequestTooLarge = errors.New("request too large")
+	// ErrIncompleteRequest represents a request that has been cut off before its end (status 451).
+	ErrIncompleteRequest = errors.New("incomplete request")
+	// ErrInvalidRequest represents a completely invalid request or an internal Livestatus error (status 452).
+	ErrInvalidRequest = errors.New("invalid request")
 	// ErrInvalidType represents an invalid type error.
 	ErrInvalidType = errors.New("invalid type")
 	// ErrUnknownColumn represents an unknown column error.
 	ErrUnknownColumn = errors.New("unknown column")
+	// ErrRowOutOfRange represents an access to a row index not present in a response.
+	ErrRowOutOfRange = errors.New("row out of range")
+	// ErrEmptyArgument represents a required command argument left empty.
+	ErrEmptyArgument = errors.New("empty required argument")
 )
 
 // ParseError embeded an error with some states to help debugging
@@ -23,3 +41,90 @@ type ParseError struct {
 func (pe ParseError) Error() string {
 	return pe.Message
 }
+
+// UnsafeValueError represents an error raised when a header value or a command argument contains characters
+// able to alter the structure of the request sent to Livestatus (e.g. newlines, or field separators in command
+// arguments).
+type UnsafeValueError struct {
+	Field    string
+	Value    string
+	Position int
+}
+
+func (e UnsafeValueError) Error() string {
+	r, _ := utf8.DecodeRuneInString(e.Value[e.Position:])
+
+	return fmt.Sprintf("unsafe value for %s: character %q at position %d in %q", e.Field, r, e.Position, e.Value)
+}
+
+// ColumnError represents an error raised when accessing a record column, either because the column is missing or
+// because its value doesn't have the expected type. It matches ErrUnknownColumn or ErrInvalidType using errors.Is.
+type ColumnError struct {
+	// Column is the name of the accessed column.
+	Column string
+	// Path locates the faulty value inside list or dictionary columns (e.g. `[2][1]` or `["KEY"]`).
+	Path string
+	// Expected is the expected value type.
+	Expected string
+	// Actual is the JSON type of the value found.
+	Actual string
+	// Value is the value found.
+	Value interface{}
+
+	Err error
+}
+
+func (e ColumnError) Error() string {
+	if e.Err == ErrUnknownColumn {
+		return fmt.Sprintf("%s %q", e.Err, e.Column)
+	}
+
+	return fmt.Sprintf("%s for column %q%s: expected %s but got %s (%v)", e.Err, e.Column, e.Path, e.Expected,
+		e.Actual, e.Value)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e ColumnError) Unwrap() error {
+	return e.Err
+}
+
+var statusErrors = map[int]error{
+	400: ErrBadRequest,
+	403: ErrForbidden,
+	404: ErrNotFound,
+	413: ErrRequestTooLarge,
+	451: ErrIncompleteRequest,
+	452: ErrInvalidRequest,
+}
+
+// ResponseError represents an error status returned by Livestatus. It matches ErrInvalidQuery and the sentinel
+// error corresponding to its status code (e.g. ErrNotFound) using errors.Is.
+type ResponseError struct {
+	// Status is the response status code.
+	Status int
+	// Message is the error message returned by Livestatus.
+	Message string
+	// Request is the text of the request sent to Livestatus.
+	Request string
+	// Response is the partial response received.
+	Response *Response
+}
+
+func (e ResponseError) Error() string {
+	return fmt.Sprintf("%s: status %d: %s", e.Unwrap(), e.Status, e.Message)
+}
+
+// Is reports whether the error matches a given target, either ErrInvalidQuery or the status sentinel error.
+func (e ResponseError) Is(target error) bool {
+	return target == ErrInvalidQuery || target == statusErrors[e.Status] && target != nil
+}
+
+// Unwrap returns the sentinel error corresponding to the response status, or ErrInvalidQuery for unknown
+// statuses.
+func (e ResponseError) Unwrap() error {
+	if err, ok := statusErrors[e.Status]; ok {
+		return err
+	}
+
+	return ErrInvalidQuery
+}
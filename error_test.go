@@ -0,0 +1,30 @@
+package livestatus
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_ResponseError(t *testing.T) {
+	for status, sentinel := range statusErrors {
+		err := error(ResponseError{Status: status, Message: "message1"})
+
+		if !errors.Is(err, sentinel) || !errors.Is(err, ErrInvalidQuery) {
+			t.Logf("\nExpected %d to match %#v and %#v\n", status, sentinel, ErrInvalidQuery)
+			t.Fail()
+		}
+
+		for other, s := range statusErrors {
+			if other != status && errors.Is(err, s) {
+				t.Logf("\nExpected %d not to match %#v\n", status, s)
+				t.Fail()
+			}
+		}
+	}
+
+	err := error(ResponseError{Status: 499, Message: "message1"})
+	if !errors.Is(err, ErrInvalidQuery) || errors.Is(err, ErrNotFound) {
+		t.Logf("\nUnexpected matching for unknown status\n")
+		t.Fail()
+	}
+}
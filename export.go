@@ -0,0 +1,327 @@
+package livestatus
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// ColumnFormat represents the formatting applied to a column value when exporting records.
+type ColumnFormat int
+
+const (
+	// FormatDefault renders values as returned by Livestatus.
+	FormatDefault ColumnFormat = iota
+	// FormatTime renders Unix timestamps as formatted dates, zero timestamps being rendered as empty values.
+	FormatTime
+	// FormatBool renders 0/1 values as booleans.
+	FormatBool
+	// FormatHostState renders host state codes as names (e.g. `UP`).
+	FormatHostState
+	// FormatServiceState renders service state codes as names (e.g. `WARNING`).
+	FormatServiceState
+)
+
+var (
+	hostStateNames    = map[int64]string{0: "UP", 1: "DOWN", 2: "UNREACHABLE"}
+	serviceStateNames = map[int64]string{0: "OK", 1: "WARNING", 2: "CRITICAL", 3: "UNKNOWN"}
+)
+
+// ExportOptions represents the options used when exporting records.
+type ExportOptions struct {
+	// Columns defines the exported columns and their order. It defaults to the response columns, or to the sorted
+	// columns of the first record when unavailable.
+	Columns []string
+
+	// Formats defines the formatting applied to specific columns.
+	Formats map[string]ColumnFormat
+
+	// TimeLayout is the layout used to render timestamps, defaulting to time.RFC3339.
+	TimeLayout string
+	// Location is the location used to render timestamps, defaulting to the local one.
+	Location *time.Location
+
+	// TrueValue and FalseValue are the texts used to render booleans, defaulting to `true` and `false`. They are
+	// ignored by the JSON lines writer, booleans being rendered as JSON values.
+	TrueValue  string
+	FalseValue string
+
+	// NoHeader disables the columns header line of the CSV and table writers.
+	NoHeader bool
+}
+
+// RecordWriter represents a records writer, allowing records to be exported one at a time.
+type RecordWriter interface {
+	// WriteRecord writes a single record.
+	WriteRecord(r Record) error
+	// Flush writes any buffered data to the underlying writer.
+	Flush() error
+}
+
+// NewCSVWriter creates a new records writer rendering records as CSV.
+func NewCSVWriter(w io.Writer, opts ExportOptions) RecordWriter {
+	return &csvWriter{w: csv.NewWriter(w), opts: opts}
+}
+
+// NewJSONLinesWriter creates a new records writer rendering each record as a JSON object on a single line, keys
+// being ordered as the exported columns.
+func NewJSONLinesWriter(w io.Writer, opts ExportOptions) RecordWriter {
+	return &jsonLinesWriter{w: w, opts: opts}
+}
+
+// NewTableWriter creates a new records writer rendering records as an aligned plain-text table. Columns widths
+// depending on all the records, the output is buffered until the writer is flushed.
+func NewTableWriter(w io.Writer, opts ExportOptions) RecordWriter {
+	return &tableWriter{w: tabwriter.NewWriter(w, 0, 8, 2, ' ', 0), opts: opts}
+}
+
+// WriteCSV writes the response records as CSV.
+func (r *Response) WriteCSV(w io.Writer, opts ExportOptions) error {
+	return r.write(NewCSVWriter(w, r.exportOptions(opts)))
+}
+
+// WriteJSONLines writes the response records as JSON lines.
+func (r *Response) WriteJSONLines(w io.Writer, opts ExportOptions) error {
+	return r.write(NewJSONLinesWriter(w, r.exportOptions(opts)))
+}
+
+// WriteTable writes the response records as an aligned plain-text table.
+func (r *Response) WriteTable(w io.Writer, opts ExportOptions) error {
+	return r.write(NewTableWriter(w, r.exportOptions(opts)))
+}
+
+func (r *Response) exportOptions(opts ExportOptions) ExportOptions {
+	if len(opts.Columns) == 0 {
+		opts.Columns = r.Columns
+	}
+
+	return opts
+}
+
+func (r *Response) write(rw RecordWriter) error {
+	for _, rec := range r.Records {
+		if err := rw.WriteRecord(rec); err != nil {
+			return err
+		}
+	}
+
+	return rw.Flush()
+}
+
+type csvWriter struct {
+	w      *csv.Writer
+	opts   ExportOptions
+	header bool
+}
+
+func (cw *csvWriter) WriteRecord(r Record) error {
+	if !cw.header {
+		cw.header = true
+		cw.opts.init(r)
+
+		if !cw.opts.NoHeader {
+			if err := cw.w.Write(cw.opts.Columns); err != nil {
+				return err
+			}
+		}
+	}
+
+	row := make([]string, len(cw.opts.Columns))
+	for i, c := range cw.opts.Columns {
+		row[i] = cw.opts.text(c, r[c])
+	}
+
+	return cw.w.Write(row)
+}
+
+func (cw *csvWriter) Flush() error {
+	if !cw.header && !cw.opts.NoHeader && len(cw.opts.Columns) > 0 {
+		cw.header = true
+		if err := cw.w.Write(cw.opts.Columns); err != nil {
+			return err
+		}
+	}
+
+	cw.w.Flush()
+
+	return cw.w.Error()
+}
+
+type jsonLinesWriter struct {
+	w      io.Writer
+	opts   ExportOptions
+	inited bool
+}
+
+func (jw *jsonLinesWriter) WriteRecord(r Record) error {
+	if !jw.inited {
+		jw.inited = true
+		jw.opts.init(r)
+	}
+
+	buf := bytes.NewBufferString("{")
+	for i, c := range jw.opts.Columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(c)
+		if err != nil {
+			return err
+		}
+
+		value, err := json.Marshal(jw.opts.value(c, r[c]))
+		if err != nil {
+			return fmt.Errorf("encoding column %q failed: %v", c, err)
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteString("}\n")
+
+	_, err := jw.w.Write(buf.Bytes())
+
+	return err
+}
+
+func (jw *jsonLinesWriter) Flush() error {
+	return nil
+}
+
+type tableWriter struct {
+	w      *tabwriter.Writer
+	opts   ExportOptions
+	header bool
+}
+
+func (tw *tableWriter) WriteRecord(r Record) error {
+	if !tw.header {
+		tw.header = true
+		tw.opts.init(r)
+
+		if !tw.opts.NoHeader {
+			if err := tw.writeRow(tw.opts.Columns); err != nil {
+				return err
+			}
+		}
+	}
+
+	row := make([]string, len(tw.opts.Columns))
+	for i, c := range tw.opts.Columns {
+		// Prevent values from breaking the table layout
+		row[i] = strings.Map(func(r rune) rune {
+			if r == '\t' || r == '\n' || r == '\r' {
+				return ' '
+			}
+			return r
+		}, tw.opts.text(c, r[c]))
+	}
+
+	return tw.writeRow(row)
+}
+
+func (tw *tableWriter) Flush() error {
+	if !tw.header && !tw.opts.NoHeader && len(tw.opts.Columns) > 0 {
+		tw.header = true
+		if err := tw.writeRow(tw.opts.Columns); err != nil {
+			return err
+		}
+	}
+
+	return tw.w.Flush()
+}
+
+func (tw *tableWriter) writeRow(row []string) error {
+	_, err := io.WriteString(tw.w, strings.Join(row, "\t")+"\n")
+	return err
+}
+
+func (o *ExportOptions) init(r Record) {
+	if len(o.Columns) == 0 {
+		o.Columns = r.Columns()
+	}
+}
+
+// text returns the text representation of a column value.
+func (o ExportOptions) text(column string, v interface{}) string {
+	switch v := o.value(column, v).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		if v {
+			return defaultString(o.TrueValue, "true")
+		}
+		return defaultString(o.FalseValue, "false")
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = o.text("", item)
+		}
+		return strings.Join(parts, ",")
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	}
+}
+
+// value returns a column value with its formatting applied, values not matching the column format being left
+// unchanged.
+func (o ExportOptions) value(column string, v interface{}) interface{} {
+	n, ok := toInt(v)
+	if !ok {
+		return v
+	}
+
+	switch o.Formats[column] {
+	case FormatTime:
+		if n == 0 {
+			return nil
+		}
+
+		loc := o.Location
+		if loc == nil {
+			loc = time.Local
+		}
+		return time.Unix(n, 0).In(loc).Format(defaultString(o.TimeLayout, time.RFC3339))
+
+	case FormatBool:
+		return n != 0
+
+	case FormatHostState:
+		if name, ok := hostStateNames[n]; ok {
+			return name
+		}
+
+	case FormatServiceState:
+		if name, ok := serviceStateNames[n]; ok {
+			return name
+		}
+	}
+
+	return v
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+
+	return s
+}
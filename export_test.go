@@ -0,0 +1,109 @@
+package livestatus
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func exportTestResponse() *Response {
+	return &Response{
+		Columns: []string{"host_name", "state", "last_check", "acknowledged", "groups"},
+		Records: []Record{
+			{"host_name": "host1", "state": int64(0), "last_check": int64(1439633040), "acknowledged": int64(0),
+				"groups": []interface{}{"group1", "group2"}},
+			{"host_name": "host,2", "state": int64(2), "last_check": int64(0), "acknowledged": int64(1),
+				"groups": []interface{}{}},
+		},
+	}
+}
+
+func exportTestOptions() ExportOptions {
+	return ExportOptions{
+		Formats: map[string]ColumnFormat{
+			"state":        FormatServiceState,
+			"last_check":   FormatTime,
+			"acknowledged": FormatBool,
+		},
+		Location:  time.UTC,
+		TrueValue: "yes",
+	}
+}
+
+func Test_ResponseWriteCSV(t *testing.T) {
+	expected := `host_name,state,last_check,acknowledged,groups
+host1,OK,2015-08-15T10:04:00Z,false,"group1,group2"
+"host,2",CRITICAL,,yes,
+`
+
+	buf := bytes.NewBuffer(nil)
+	if err := exportTestResponse().WriteCSV(buf, exportTestOptions()); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, buf.String())
+		t.Fail()
+	}
+
+	// Ensure requested columns order is honored
+	expected = `state,host_name
+OK,host1
+CRITICAL,"host,2"
+`
+
+	opts := exportTestOptions()
+	opts.Columns = []string{"state", "host_name"}
+
+	buf.Reset()
+	if err := exportTestResponse().WriteCSV(buf, opts); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, buf.String())
+		t.Fail()
+	}
+}
+
+func Test_ResponseWriteJSONLines(t *testing.T) {
+	expected := `{"host_name":"host1","state":"OK","last_check":"2015-08-15T10:04:00Z","acknowledged":false,` +
+		`"groups":["group1","group2"]}
+{"host_name":"host,2","state":"CRITICAL","last_check":null,"acknowledged":true,"groups":[]}
+`
+
+	buf := bytes.NewBuffer(nil)
+	if err := exportTestResponse().WriteJSONLines(buf, exportTestOptions()); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, buf.String())
+		t.Fail()
+	}
+}
+
+func Test_ResponseWriteTable(t *testing.T) {
+	expected := `host_name  state     last_check            acknowledged  groups
+host1      OK        2015-08-15T10:04:00Z  false         group1,group2
+host,2     CRITICAL                        yes           
+`
+
+	buf := bytes.NewBuffer(nil)
+	if err := exportTestResponse().WriteTable(buf, exportTestOptions()); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, buf.String())
+		t.Fail()
+	}
+}
+
+func Test_RecordWriterDefaultColumns(t *testing.T) {
+	expected := "name,value\nname1,1.5\n"
+
+	buf := bytes.NewBuffer(nil)
+
+	w := NewCSVWriter(buf, ExportOptions{})
+	if err := w.WriteRecord(Record{"value": 1.5, "name": "name1"}); err != nil {
+		t.Fatal(err)
+	} else if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, buf.String())
+		t.Fail()
+	}
+}
@@ -0,0 +1,194 @@
+package livestatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LogFollower represents a Livestatus log follower, streaming new log entries as they are written, similarly to
+// `tail -f`.
+type LogFollower struct {
+	client  *Client
+	lq      *LogQuery
+	since   time.Time
+	timeout time.Duration
+	backoff backoff
+	onError func(error)
+}
+
+// NewLogFollower creates a new log follower, starting with the entries logged from now on. The follower uses its
+// own connection, based on the client network settings.
+func NewLogFollower(c *Client) *LogFollower {
+	return &LogFollower{
+		client:  c.clone(),
+		lq:      NewLogQuery(time.Time{}, time.Time{}),
+		timeout: DefaultSubscriptionTimeout,
+		backoff: backoff{min: defaultMinBackoff, max: defaultMaxBackoff},
+	}
+}
+
+// Since sets the time from which log entries are followed.
+func (f *LogFollower) Since(t time.Time) *LogFollower {
+	f.since = t
+	return f
+}
+
+// Classes restricts the followed entries to the ones matching any of the given classes.
+func (f *LogFollower) Classes(classes ...LogClass) *LogFollower {
+	f.lq.Classes(classes...)
+	return f
+}
+
+// Types restricts the followed entries to the ones matching any of the given types.
+func (f *LogFollower) Types(types ...LogType) *LogFollower {
+	f.lq.Types(types...)
+	return f
+}
+
+// Filter appends a new filter to the log queries.
+func (f *LogFollower) Filter(rule string) *LogFollower {
+	f.lq.Filter(rule)
+	return f
+}
+
+// FilterValue appends a new filter to the log queries, comparing a column with a value using a given operator.
+func (f *LogFollower) FilterValue(column, operator string, value interface{}) *LogFollower {
+	f.lq.FilterValue(column, operator, value)
+	return f
+}
+
+// Timeout sets the upper limit on the time each query waits for new log entries. A value of 0 waits forever.
+func (f *LogFollower) Timeout(d time.Duration) *LogFollower {
+	f.timeout = d
+	return f
+}
+
+// Backoff sets the minimum and maximum delays to wait before reconnecting after a failure, the delay being
+// doubled after each consecutive failure.
+func (f *LogFollower) Backoff(min, max time.Duration) *LogFollower {
+	f.backoff = backoff{min: min, max: max}
+	return f
+}
+
+// OnError sets a function called each time a log query fails.
+func (f *LogFollower) OnError(fn func(error)) *LogFollower {
+	f.onError = fn
+	return f
+}
+
+// Run follows the log until the context is cancelled, calling fn for each new log entry. Entries are delivered
+// in order, each entry being delivered only once even if several of them share the same timestamp.
+func (f *LogFollower) Run(ctx context.Context, fn func(LogEntry)) error {
+	if err := f.lq.base.Err(); err != nil {
+		return err
+	}
+
+	defer f.client.Close()
+
+	// Close the connection on cancellation to interrupt any pending wait query
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			f.client.Close()
+		case <-stop:
+		}
+	}()
+
+	last := f.since
+	if last.IsZero() {
+		last = f.client.clock.Now()
+	}
+	last = time.Unix(last.Unix(), 0)
+
+	seen := map[string]struct{}{}
+	wait := false
+
+	for {
+		resp, err := f.client.Exec(f.query(last, wait))
+		if ctx.Err() != nil {
+			return ctx.Err()
+		} else if err != nil {
+			f.client.Close()
+
+			if f.onError != nil {
+				f.onError(err)
+			}
+
+			if !sleepContext(ctx, f.backoff.next()) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		f.backoff.reset()
+		wait = true
+
+		entries := make([]LogEntry, 0, len(resp.Records))
+		for _, r := range resp.Records {
+			e, err := NewLogEntry(r)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, e)
+		}
+		sortLogEntries(entries)
+
+		for _, e := range entries {
+			key := fmt.Sprintf("%d\x00%s", e.LineNo, e.Message)
+
+			if e.Time.Before(last) {
+				continue
+			} else if e.Time.After(last) {
+				last = e.Time
+				seen = map[string]struct{}{}
+			} else if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			fn(e)
+		}
+	}
+}
+
+// Entries follows the log in a separate goroutine until the context is cancelled, returning a channel on which
+// the new entries are sent. The channel is closed once the follower is stopped.
+func (f *LogFollower) Entries(ctx context.Context) <-chan LogEntry {
+	ch := make(chan LogEntry)
+
+	go func() {
+		defer close(ch)
+
+		err := f.Run(ctx, func(e LogEntry) {
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+			}
+		})
+		if err != nil && err != ctx.Err() && f.onError != nil {
+			f.onError(err)
+		}
+	}()
+
+	return ch
+}
+
+// query returns the log query issued for the entries logged since a given time, waiting for new entries if wait is
+// true.
+func (f *LogFollower) query(since time.Time, wait bool) *Query {
+	q := f.lq.query(since, time.Time{})
+	if wait {
+		q.WaitTrigger(string(TriggerLog))
+		q.WaitTimeout(f.timeout)
+		if f.timeout > 0 {
+			q.ReadTimeout(f.timeout + subscriptionReadMargin)
+		}
+	}
+	q.KeepAlive()
+
+	return q
+}
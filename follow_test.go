@@ -0,0 +1,86 @@
+package livestatus
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_LogFollower(t *testing.T) {
+	var mu sync.Mutex
+
+	type entry struct{ time, lineno int }
+	entries := []entry{{90, 1}, {100, 1}, {100, 2}}
+
+	re := regexp.MustCompile(`Filter: time >= (\d+)\n`)
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if strings.Contains(req, "WaitTrigger: log\n") {
+			entries = append(entries, entry{100, 3}, entry{101, 1})
+		}
+
+		m := re.FindStringSubmatch(req)
+		if m == nil {
+			return 400, "missing time filter\n"
+		}
+		since, _ := strconv.Atoi(m[1])
+
+		rows := []string{}
+		for _, e := range entries {
+			if e.time >= since {
+				rows = append(rows, fmt.Sprintf(`[%d,%d,1,"HOST ALERT","msg%d","","host1","","","",1,"SOFT",1,"",""]`,
+					e.time, e.lineno, e.lineno))
+			}
+		}
+
+		return 200, "[" + strings.Join(rows, ",") + "]"
+	})
+	defer stop()
+
+	f := NewLogFollower(NewClient("tcp", addr)).Since(time.Unix(95, 0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	result := []string{}
+	for e := range f.Entries(ctx) {
+		result = append(result, fmt.Sprintf("%d/%d", e.Time.Unix(), e.LineNo))
+		if len(result) == 4 {
+			cancel()
+		}
+	}
+
+	expected := []string{"100/1", "100/2", "100/3", "101/1"}
+	if strings.Join(result, " ") != strings.Join(expected, " ") {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_LogFollowerQueryNoTimeout(t *testing.T) {
+	f := NewLogFollower(NewClient("tcp", "127.0.0.1:0")).Timeout(0)
+
+	q := f.query(time.Unix(100, 0), true)
+	if !strings.Contains(q.String(), "WaitTimeout: 0\n") {
+		t.Logf("\nExpected WaitTimeout header\nbut got  %q\n", q.String())
+		t.Fail()
+	}
+
+	if q.readTimeout != 0 {
+		t.Logf("\nExpected no read timeout\nbut got  %s\n", q.readTimeout)
+		t.Fail()
+	}
+
+	if q = f.Timeout(time.Second).query(time.Unix(100, 0), true); q.readTimeout != time.Second+subscriptionReadMargin {
+		t.Logf("\nExpected %s\nbut got  %s\n", time.Second+subscriptionReadMargin, q.readTimeout)
+		t.Fail()
+	}
+}
@@ -0,0 +1,95 @@
+// Generated by go generate; DO NOT EDIT.
+
+package icinga
+
+import (
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+	"github.com/vbatoufflet/go-livestatus/nagios"
+)
+
+// AcknowledgeHostProblemExpire creates a new "ACKNOWLEDGE_HOST_PROBLEM_EXPIRE" Icinga command.
+//
+// Allows you to acknowledge the current problem for the specified host, the acknowledgement being automatically
+// removed at the time specified by the "end_time" option.
+//
+// The "sticky", "notify" and "persistent" options behave as for the ACKNOWLEDGE_HOST_PROBLEM command.
+//
+// The "end_time" argument is specified in time_t format (seconds since the UNIX epoch).
+func AcknowledgeHostProblemExpire(
+	host_name string,
+	sticky nagios.AckSticky,
+	notify bool,
+	persistent bool,
+	end_time time.Time,
+	author string,
+	comment string,
+) *livestatus.Command {
+	return livestatus.NewCommand("ACKNOWLEDGE_HOST_PROBLEM_EXPIRE").
+		RequiredArg(host_name).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(end_time).
+		Arg(author).
+		Arg(comment)
+}
+
+// AcknowledgeSvcProblemExpire creates a new "ACKNOWLEDGE_SVC_PROBLEM_EXPIRE" Icinga command.
+//
+// Allows you to acknowledge the current problem for the specified service, the acknowledgement being automatically
+// removed at the time specified by the "end_time" option.
+//
+// The "sticky", "notify" and "persistent" options behave as for the ACKNOWLEDGE_SVC_PROBLEM command.
+//
+// The "end_time" argument is specified in time_t format (seconds since the UNIX epoch).
+func AcknowledgeSvcProblemExpire(
+	host_name string,
+	service_description string,
+	sticky nagios.AckSticky,
+	notify bool,
+	persistent bool,
+	end_time time.Time,
+	author string,
+	comment string,
+) *livestatus.Command {
+	return livestatus.NewCommand("ACKNOWLEDGE_SVC_PROBLEM_EXPIRE").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(end_time).
+		Arg(author).
+		Arg(comment)
+}
+
+// Commands is the catalog of the Icinga commands, used to parse commands text representations.
+var Commands = nagios.Catalog{
+	"ACKNOWLEDGE_HOST_PROBLEM_EXPIRE": {
+		Name: "ACKNOWLEDGE_HOST_PROBLEM_EXPIRE",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+			{Name: "sticky", Type: nagios.ArgAckSticky},
+			{Name: "notify", Type: nagios.ArgBool},
+			{Name: "persistent", Type: nagios.ArgBool},
+			{Name: "end_time", Type: nagios.ArgTime},
+			{Name: "author", Type: nagios.ArgString},
+			{Name: "comment", Type: nagios.ArgString},
+		},
+	},
+	"ACKNOWLEDGE_SVC_PROBLEM_EXPIRE": {
+		Name: "ACKNOWLEDGE_SVC_PROBLEM_EXPIRE",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+			{Name: "service_description", Type: nagios.ArgString, Required: true},
+			{Name: "sticky", Type: nagios.ArgAckSticky},
+			{Name: "notify", Type: nagios.ArgBool},
+			{Name: "persistent", Type: nagios.ArgBool},
+			{Name: "end_time", Type: nagios.ArgTime},
+			{Name: "author", Type: nagios.ArgString},
+			{Name: "comment", Type: nagios.ArgString},
+		},
+	},
+}
@@ -0,0 +1,43 @@
+// Generated by go generate; DO NOT EDIT.
+
+package icinga
+
+import (
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+	"github.com/vbatoufflet/go-livestatus/nagios"
+)
+
+func Test_Commands(t *testing.T) {
+	for _, test := range []struct {
+		cmd      *livestatus.Command
+		expected string
+	}{
+		{
+			AcknowledgeHostProblemExpire("host_name", nagios.AckStickyUntilOK, true, true, time.Unix(1439633040, 0), "author", "comment"),
+			"COMMAND [1439633040] ACKNOWLEDGE_HOST_PROBLEM_EXPIRE;host_name;2;1;1;1439633040;author;comment\n\n",
+		},
+		{
+			AcknowledgeSvcProblemExpire("host_name", "service_description", nagios.AckStickyUntilOK, true, true, time.Unix(1439633040, 0), "author", "comment"),
+			"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM_EXPIRE;host_name;service_description;2;1;1;1439633040;author;comment\n\n",
+		},
+	} {
+		result := test.cmd.Timestamp(time.Unix(1439633040, 0)).String()
+
+		if err := test.cmd.Err(); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if result != test.expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		} else if pc, err := Commands.Parse(result); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if rt := pc.Command().String(); rt != result {
+			t.Logf("\nExpected %q\nbut got  %q\n", result, rt)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,5 @@
+// Package icinga provides the Icinga specific external commands, the common ones being provided by the nagios
+// package.
+package icinga
+
+//go:generate go run ../nagios/internal/generate-commands/main.go -spec commands.json -o commands.go -test commands_test.go
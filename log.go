@@ -0,0 +1,287 @@
+package livestatus
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultLogChunk is the default duration of the time windows long log queries are split into.
+const DefaultLogChunk = time.Hour
+
+// LogClass represents a Livestatus log entry class.
+type LogClass int
+
+const (
+	// LogClassInfo represents all the messages not matching any other class.
+	LogClassInfo LogClass = iota
+	// LogClassAlert represents host and service alerts.
+	LogClassAlert
+	// LogClassProgram represents important program events (e.g. restarts).
+	LogClassProgram
+	// LogClassNotification represents host and service notifications.
+	LogClassNotification
+	// LogClassPassive represents passive checks.
+	LogClassPassive
+	// LogClassCommand represents external commands.
+	LogClassCommand
+	// LogClassState represents initial and current state entries.
+	LogClassState
+	// LogClassProgramState represents program state changes.
+	LogClassProgramState
+)
+
+// LogType represents a Livestatus log entry type.
+type LogType string
+
+// Log entry types
+const (
+	LogTypeHostAlert                 LogType = "HOST ALERT"
+	LogTypeServiceAlert              LogType = "SERVICE ALERT"
+	LogTypeHostNotification          LogType = "HOST NOTIFICATION"
+	LogTypeServiceNotification       LogType = "SERVICE NOTIFICATION"
+	LogTypeHostDowntimeAlert         LogType = "HOST DOWNTIME ALERT"
+	LogTypeServiceDowntimeAlert      LogType = "SERVICE DOWNTIME ALERT"
+	LogTypeHostFlappingAlert         LogType = "HOST FLAPPING ALERT"
+	LogTypeServiceFlappingAlert      LogType = "SERVICE FLAPPING ALERT"
+	LogTypeInitialHostState          LogType = "INITIAL HOST STATE"
+	LogTypeInitialServiceState       LogType = "INITIAL SERVICE STATE"
+	LogTypeCurrentHostState          LogType = "CURRENT HOST STATE"
+	LogTypeCurrentServiceState       LogType = "CURRENT SERVICE STATE"
+	LogTypePassiveHostCheck          LogType = "PASSIVE HOST CHECK"
+	LogTypePassiveServiceCheck       LogType = "PASSIVE SERVICE CHECK"
+	LogTypeExternalCommand           LogType = "EXTERNAL COMMAND"
+	LogTypeLogRotation               LogType = "LOG ROTATION"
+	LogTypeLogVersion                LogType = "LOG VERSION"
+	LogTypeTimeperiodTransition      LogType = "TIMEPERIOD TRANSITION"
+	LogTypeHostEventHandler          LogType = "HOST EVENT HANDLER"
+	LogTypeServiceEventHandler       LogType = "SERVICE EVENT HANDLER"
+	LogTypeHostNotificationResult    LogType = "HOST NOTIFICATION RESULT"
+	LogTypeServiceNotificationResult LogType = "SERVICE NOTIFICATION RESULT"
+)
+
+// StateType represents the type of a host or service state.
+type StateType string
+
+// State types
+const (
+	StateTypeSoft StateType = "SOFT"
+	StateTypeHard StateType = "HARD"
+)
+
+var logEntryColumns = []string{
+	"time",
+	"lineno",
+	"class",
+	"type",
+	"message",
+	"options",
+	"host_name",
+	"service_description",
+	"contact_name",
+	"command_name",
+	"state",
+	"state_type",
+	"attempt",
+	"plugin_output",
+	"comment",
+}
+
+// LogEntry represents a Livestatus log table entry.
+type LogEntry struct {
+	Time               time.Time
+	LineNo             int64
+	Class              LogClass
+	Type               LogType
+	Message            string
+	Options            string
+	HostName           string
+	ServiceDescription string
+	ContactName        string
+	CommandName        string
+	State              int64
+	StateType          StateType
+	Attempt            int64
+	PluginOutput       string
+	Comment            string
+}
+
+// NewLogEntry creates a new log entry from a log table record.
+func NewLogEntry(r Record) (LogEntry, error) {
+	rr := r.Reader()
+
+	e := LogEntry{
+		Time:               rr.Time("time"),
+		LineNo:             rr.Int("lineno"),
+		Class:              LogClass(rr.Int("class")),
+		Type:               LogType(rr.String("type")),
+		Message:            rr.String("message"),
+		Options:            rr.String("options"),
+		HostName:           rr.String("host_name"),
+		ServiceDescription: rr.String("service_description"),
+		ContactName:        rr.String("contact_name"),
+		CommandName:        rr.String("command_name"),
+		State:              rr.Int("state"),
+		StateType:          StateType(rr.String("state_type")),
+		Attempt:            rr.Int("attempt"),
+		PluginOutput:       rr.String("plugin_output"),
+		Comment:            rr.String("comment"),
+	}
+	if err := rr.Err(); err != nil {
+		return LogEntry{}, err
+	}
+
+	return e, nil
+}
+
+// LogQuery represents a Livestatus log table query, bounded by a mandatory time window.
+//
+// Long time windows are split into several smaller queries (see ChunkSize) to preserve the monitoring core from
+// scanning too many log files at once.
+type LogQuery struct {
+	start   time.Time
+	end     time.Time
+	chunk   time.Duration
+	classes []LogClass
+	types   []LogType
+	base    *Query
+}
+
+// NewLogQuery creates a new log table query matching entries logged between start (inclusive) and end
+// (exclusive).
+func NewLogQuery(start, end time.Time) *LogQuery {
+	return &LogQuery{
+		start: start,
+		end:   end,
+		chunk: DefaultLogChunk,
+		base:  NewQuery("log").Columns(logEntryColumns...),
+	}
+}
+
+// Classes restricts the query to entries matching any of the given classes.
+func (lq *LogQuery) Classes(classes ...LogClass) *LogQuery {
+	lq.classes = append(lq.classes, classes...)
+	return lq
+}
+
+// Types restricts the query to entries matching any of the given types.
+func (lq *LogQuery) Types(types ...LogType) *LogQuery {
+	lq.types = append(lq.types, types...)
+	return lq
+}
+
+// Filter appends a new filter to the query.
+func (lq *LogQuery) Filter(rule string) *LogQuery {
+	lq.base.Filter(rule)
+	return lq
+}
+
+// FilterValue appends a new filter to the query, comparing a column with a value using a given operator.
+func (lq *LogQuery) FilterValue(column, operator string, value interface{}) *LogQuery {
+	lq.base.FilterValue(column, operator, value)
+	return lq
+}
+
+// AuthUser restricts the query results to the entries a given contact is authorized to see.
+func (lq *LogQuery) AuthUser(name string) *LogQuery {
+	lq.base.AuthUser(name)
+	return lq
+}
+
+// ChunkSize sets the maximum duration of the time window covered by each query sent to Livestatus.
+// A value of 0 disables the chunking.
+func (lq *LogQuery) ChunkSize(d time.Duration) *LogQuery {
+	lq.chunk = d
+	return lq
+}
+
+// Queries returns the list of Livestatus queries covering the log query time window.
+func (lq *LogQuery) Queries() ([]*Query, error) {
+	if lq.start.IsZero() || lq.end.IsZero() {
+		return nil, fmt.Errorf("log query time window is mandatory")
+	} else if !lq.end.After(lq.start) {
+		return nil, fmt.Errorf("log query time window end %s is not after start %s", lq.end, lq.start)
+	} else if err := lq.base.Err(); err != nil {
+		return nil, err
+	}
+
+	queries := []*Query{}
+
+	for start := lq.start; start.Before(lq.end); {
+		end := lq.end
+		if lq.chunk > 0 && start.Add(lq.chunk).Before(end) {
+			end = start.Add(lq.chunk)
+		}
+
+		queries = append(queries, lq.query(start, end))
+		start = end
+	}
+
+	return queries, nil
+}
+
+func (lq *LogQuery) query(start, end time.Time) *Query {
+	q := lq.base.Clone()
+	q.FilterValue("time", ">=", start)
+	if !end.IsZero() {
+		q.FilterValue("time", "<", end)
+	}
+
+	if len(lq.classes) > 0 {
+		for _, class := range lq.classes {
+			q.FilterValue("class", "=", int(class))
+		}
+		if len(lq.classes) > 1 {
+			q.Or(len(lq.classes))
+		}
+	}
+
+	if len(lq.types) > 0 {
+		for _, typ := range lq.types {
+			q.FilterValue("type", "=", string(typ))
+		}
+		if len(lq.types) > 1 {
+			q.Or(len(lq.types))
+		}
+	}
+
+	return q
+}
+
+// ExecLog executes a given log query, returning the matching entries sorted by time.
+func (c *Client) ExecLog(lq *LogQuery) ([]LogEntry, error) {
+	queries, err := lq.Queries()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []LogEntry{}
+
+	for _, q := range queries {
+		resp, err := c.Exec(q)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range resp.Records {
+			e, err := NewLogEntry(r)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		}
+	}
+
+	sortLogEntries(entries)
+
+	return entries, nil
+}
+
+func sortLogEntries(entries []LogEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Time.Equal(entries[j].Time) {
+			return entries[i].Time.Before(entries[j].Time)
+		}
+		return entries[i].LineNo < entries[j].LineNo
+	})
+}
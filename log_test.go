@@ -0,0 +1,145 @@
+package livestatus
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_LogQuery(t *testing.T) {
+	expected := `GET log
+Columns: ` + strings.Join(logEntryColumns, " ") + `
+Filter: host_name = host1
+Filter: time >= 1439629200
+Filter: time < 1439632800
+Filter: class = 1
+Filter: class = 3
+Or: 2
+Filter: type = SERVICE ALERT
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	lq := NewLogQuery(time.Unix(1439629200, 0), time.Unix(1439640000, 0))
+	lq.FilterValue("host_name", "=", "host1")
+	lq.Classes(LogClassAlert, LogClassNotification)
+	lq.Types(LogTypeServiceAlert)
+
+	queries, err := lq.Queries()
+	if err != nil {
+		t.Fatal(err)
+	} else if len(queries) != 3 {
+		t.Fatalf("\nExpected 3 queries\nbut got  %d\n", len(queries))
+	}
+
+	result := queries[0].String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+
+	if !strings.Contains(queries[2].String(), "Filter: time >= 1439636400\nFilter: time < 1439640000\n") {
+		t.Logf("\nUnexpected last chunk %q\n", queries[2].String())
+		t.Fail()
+	}
+
+	queries, err = lq.ChunkSize(0).Queries()
+	if err != nil {
+		t.Fatal(err)
+	} else if len(queries) != 1 {
+		t.Logf("\nExpected 1 query\nbut got  %d\n", len(queries))
+		t.Fail()
+	}
+}
+
+func Test_LogQueryInvalidWindow(t *testing.T) {
+	for _, lq := range []*LogQuery{
+		NewLogQuery(time.Time{}, time.Unix(1439640000, 0)),
+		NewLogQuery(time.Unix(1439640000, 0), time.Unix(1439629200, 0)),
+	} {
+		if _, err := lq.Queries(); err == nil {
+			t.Logf("\nExpected error\nbut got  nil\n")
+			t.Fail()
+		}
+	}
+}
+
+func Test_NewLogEntry(t *testing.T) {
+	record := Record{
+		"time":                1439629200.0,
+		"lineno":              12.0,
+		"class":               1.0,
+		"type":                "SERVICE ALERT",
+		"message":             "SERVICE ALERT: host1;service1;CRITICAL;HARD;3;output1",
+		"options":             "host1;service1;CRITICAL;HARD;3;output1",
+		"host_name":           "host1",
+		"service_description": "service1",
+		"contact_name":        "",
+		"command_name":        "",
+		"state":               2.0,
+		"state_type":          "HARD",
+		"attempt":             3.0,
+		"plugin_output":       "output1",
+		"comment":             "",
+	}
+
+	expected := LogEntry{
+		Time:               time.Unix(1439629200, 0),
+		LineNo:             12,
+		Class:              LogClassAlert,
+		Type:               LogTypeServiceAlert,
+		Message:            "SERVICE ALERT: host1;service1;CRITICAL;HARD;3;output1",
+		Options:            "host1;service1;CRITICAL;HARD;3;output1",
+		HostName:           "host1",
+		ServiceDescription: "service1",
+		State:              2,
+		StateType:          StateTypeHard,
+		Attempt:            3,
+		PluginOutput:       "output1",
+	}
+
+	result, err := NewLogEntry(record)
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+
+	delete(record, "attempt")
+
+	if _, err = NewLogEntry(record); err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+}
+
+func Test_ClientExecLog(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		if strings.Contains(req, "Filter: time >= 1439629200\n") {
+			return 200, `[[1439629300,2,1,"HOST ALERT","","","host1","","","",1,"SOFT",1,"",""],` +
+				`[1439629200,1,1,"HOST ALERT","","","host1","","","",1,"SOFT",1,"",""]]`
+		}
+		return 200, `[[1439632900,1,1,"HOST ALERT","","","host1","","","",0,"HARD",1,"",""]]`
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	entries, err := c.ExecLog(NewLogQuery(time.Unix(1439629200, 0), time.Unix(1439636400, 0)))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(entries) != 3 {
+		t.Fatalf("\nExpected 3 entries\nbut got  %d\n", len(entries))
+	}
+
+	for i, ts := range []int64{1439629200, 1439629300, 1439632900} {
+		if entries[i].Time.Unix() != ts {
+			t.Logf("\nExpected %d\nbut got  %d\n", ts, entries[i].Time.Unix())
+			t.Fail()
+		}
+	}
+}
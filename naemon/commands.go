@@ -0,0 +1,177 @@
+// Generated by go generate; DO NOT EDIT.
+
+package naemon
+
+import (
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+	"github.com/vbatoufflet/go-livestatus/nagios"
+)
+
+// AcknowledgeHostProblemExpire creates a new "ACKNOWLEDGE_HOST_PROBLEM_EXPIRE" Naemon command.
+//
+// Allows you to acknowledge the current problem for the specified host, the acknowledgement being automatically
+// removed at the time specified by the "end_time" option.
+//
+// The "sticky", "notify" and "persistent" options behave as for the ACKNOWLEDGE_HOST_PROBLEM command.
+//
+// The "end_time" argument is specified in time_t format (seconds since the UNIX epoch).
+//
+// Supported by Naemon.
+func AcknowledgeHostProblemExpire(
+	host_name string,
+	sticky nagios.AckSticky,
+	notify bool,
+	persistent bool,
+	end_time time.Time,
+	author string,
+	comment string,
+) *livestatus.Command {
+	return livestatus.NewCommand("ACKNOWLEDGE_HOST_PROBLEM_EXPIRE").
+		RequiredArg(host_name).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(end_time).
+		Arg(author).
+		Arg(comment)
+}
+
+// AcknowledgeSvcProblemExpire creates a new "ACKNOWLEDGE_SVC_PROBLEM_EXPIRE" Naemon command.
+//
+// Allows you to acknowledge the current problem for the specified service, the acknowledgement being automatically
+// removed at the time specified by the "end_time" option.
+//
+// The "sticky", "notify" and "persistent" options behave as for the ACKNOWLEDGE_SVC_PROBLEM command.
+//
+// The "end_time" argument is specified in time_t format (seconds since the UNIX epoch).
+//
+// Supported by Naemon.
+func AcknowledgeSvcProblemExpire(
+	host_name string,
+	service_description string,
+	sticky nagios.AckSticky,
+	notify bool,
+	persistent bool,
+	end_time time.Time,
+	author string,
+	comment string,
+) *livestatus.Command {
+	return livestatus.NewCommand("ACKNOWLEDGE_SVC_PROBLEM_EXPIRE").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(end_time).
+		Arg(author).
+		Arg(comment)
+}
+
+// DelDowntimeByHostgroupName creates a new "DEL_DOWNTIME_BY_HOSTGROUP_NAME" Naemon command.
+//
+// Deletes all the scheduled downtimes of the hosts of a particular hostgroup, including the ones of their services.
+func DelDowntimeByHostgroupName(
+	hostgroup_name string,
+) *livestatus.Command {
+	return livestatus.NewCommand("DEL_DOWNTIME_BY_HOSTGROUP_NAME").
+		RequiredArg(hostgroup_name)
+}
+
+// DelDowntimeByHostName creates a new "DEL_DOWNTIME_BY_HOST_NAME" Naemon command.
+//
+// Deletes all the scheduled downtimes of a particular host, including the ones of its services.
+func DelDowntimeByHostName(
+	host_name string,
+) *livestatus.Command {
+	return livestatus.NewCommand("DEL_DOWNTIME_BY_HOST_NAME").
+		RequiredArg(host_name)
+}
+
+// DelDowntimeByStartTimeComment creates a new "DEL_DOWNTIME_BY_START_TIME_COMMENT" Naemon command.
+//
+// Deletes all the scheduled downtimes matching a particular start time and comment.
+//
+// The "start_time" argument is specified in time_t format (seconds since the UNIX epoch).
+func DelDowntimeByStartTimeComment(
+	start_time time.Time,
+	comment string,
+) *livestatus.Command {
+	return livestatus.NewCommand("DEL_DOWNTIME_BY_START_TIME_COMMENT").
+		Arg(start_time).
+		Arg(comment)
+}
+
+// DisableNotificationsExpireTime creates a new "DISABLE_NOTIFICATIONS_EXPIRE_TIME" Naemon command.
+//
+// Disables host and service notifications on a program-wide basis, starting at the time specified by the
+// "schedule_time" option, notifications being automatically enabled again at the time specified by the "expire_time"
+// option.
+//
+// The "schedule_time" and "expire_time" arguments are specified in time_t format (seconds since the UNIX epoch).
+//
+// Supported by Naemon.
+func DisableNotificationsExpireTime(
+	schedule_time time.Time,
+	expire_time time.Time,
+) *livestatus.Command {
+	return livestatus.NewCommand("DISABLE_NOTIFICATIONS_EXPIRE_TIME").
+		Arg(schedule_time).
+		Arg(expire_time)
+}
+
+// Commands is the catalog of the Naemon commands, used to parse commands text representations.
+var Commands = nagios.Catalog{
+	"ACKNOWLEDGE_HOST_PROBLEM_EXPIRE": {
+		Name: "ACKNOWLEDGE_HOST_PROBLEM_EXPIRE",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+			{Name: "sticky", Type: nagios.ArgAckSticky},
+			{Name: "notify", Type: nagios.ArgBool},
+			{Name: "persistent", Type: nagios.ArgBool},
+			{Name: "end_time", Type: nagios.ArgTime},
+			{Name: "author", Type: nagios.ArgString},
+			{Name: "comment", Type: nagios.ArgString},
+		},
+	},
+	"ACKNOWLEDGE_SVC_PROBLEM_EXPIRE": {
+		Name: "ACKNOWLEDGE_SVC_PROBLEM_EXPIRE",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+			{Name: "service_description", Type: nagios.ArgString, Required: true},
+			{Name: "sticky", Type: nagios.ArgAckSticky},
+			{Name: "notify", Type: nagios.ArgBool},
+			{Name: "persistent", Type: nagios.ArgBool},
+			{Name: "end_time", Type: nagios.ArgTime},
+			{Name: "author", Type: nagios.ArgString},
+			{Name: "comment", Type: nagios.ArgString},
+		},
+	},
+	"DEL_DOWNTIME_BY_HOSTGROUP_NAME": {
+		Name: "DEL_DOWNTIME_BY_HOSTGROUP_NAME",
+		Args: []nagios.ArgSpec{
+			{Name: "hostgroup_name", Type: nagios.ArgString, Required: true},
+		},
+	},
+	"DEL_DOWNTIME_BY_HOST_NAME": {
+		Name: "DEL_DOWNTIME_BY_HOST_NAME",
+		Args: []nagios.ArgSpec{
+			{Name: "host_name", Type: nagios.ArgString, Required: true},
+		},
+	},
+	"DEL_DOWNTIME_BY_START_TIME_COMMENT": {
+		Name: "DEL_DOWNTIME_BY_START_TIME_COMMENT",
+		Args: []nagios.ArgSpec{
+			{Name: "start_time", Type: nagios.ArgTime},
+			{Name: "comment", Type: nagios.ArgString},
+		},
+	},
+	"DISABLE_NOTIFICATIONS_EXPIRE_TIME": {
+		Name: "DISABLE_NOTIFICATIONS_EXPIRE_TIME",
+		Args: []nagios.ArgSpec{
+			{Name: "schedule_time", Type: nagios.ArgTime},
+			{Name: "expire_time", Type: nagios.ArgTime},
+		},
+	},
+}
@@ -0,0 +1,157 @@
+package nagios
+
+import (
+	"fmt"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+// AckOptions represents the settings of bulk acknowledgements.
+type AckOptions struct {
+	Sticky     AckSticky
+	Notify     bool
+	Persistent bool
+	Author     string
+	Comment    string
+
+	// DryRun builds the acknowledgement commands without sending them.
+	DryRun bool
+
+	// ChunkSize and Rate set the sending pace of the commands, as livestatus.CommandBatch ChunkSize and Rate.
+	ChunkSize int
+	Rate      float64
+}
+
+// Service represents a service identified by its host name and description.
+type Service struct {
+	HostName    string
+	Description string
+}
+
+// AckSummary represents the summary of a bulk acknowledgement.
+type AckSummary struct {
+	// Hosts contains the names of the acknowledged hosts.
+	Hosts []string
+	// Services contains the acknowledged services.
+	Services []Service
+
+	// Batch contains the acknowledgement commands, its String method returning the text sent or to be sent.
+	Batch  *livestatus.CommandBatch
+	DryRun bool
+}
+
+// String returns a human-readable summary of the objects acknowledged.
+func (s AckSummary) String() string {
+	hosts := map[string]struct{}{}
+	for _, svc := range s.Services {
+		hosts[svc.HostName] = struct{}{}
+	}
+
+	verb := "acknowledged"
+	if s.DryRun {
+		verb = "to acknowledge"
+	}
+
+	return fmt.Sprintf("%d hosts and %d services on %d hosts %s", len(s.Hosts), len(s.Services), len(hosts), verb)
+}
+
+// UnhandledHostProblems returns a query selecting the hosts having an unhandled problem: down or unreachable,
+// not acknowledged and not in a scheduled downtime.
+func UnhandledHostProblems() *livestatus.Query {
+	return livestatus.NewQuery("hosts").
+		Columns("name").
+		FilterValue("state", "!=", 0).
+		FilterValue("acknowledged", "=", 0).
+		FilterValue("scheduled_downtime_depth", "=", 0)
+}
+
+// UnhandledServiceProblems returns a query selecting the services having an unhandled problem: not OK, not
+// acknowledged and not in a scheduled downtime.
+func UnhandledServiceProblems() *livestatus.Query {
+	return livestatus.NewQuery("services").
+		Columns("host_name", "description").
+		FilterValue("state", "!=", 0).
+		FilterValue("acknowledged", "=", 0).
+		FilterValue("scheduled_downtime_depth", "=", 0)
+}
+
+// AcknowledgeProblems acknowledges the problems of the hosts or services returned by a given query, the hosts
+// table queries having to return the `name` column and the services table ones the `host_name` and `description`
+// columns (e.g. `UnhandledServiceProblems().Filter("host_name ~ ^db")`).
+//
+// The commands are sent as a single batch, unless DryRun is set. If sending fails, the returned summary still
+// contains all the commands, the error being a livestatus.BatchError reporting the commands written.
+func AcknowledgeProblems(c *livestatus.Client, q *livestatus.Query, opts AckOptions) (*AckSummary, error) {
+	resp, err := c.Exec(q)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &AckSummary{
+		Hosts:    []string{},
+		Services: []Service{},
+		Batch:    livestatus.NewCommandBatch().ChunkSize(opts.ChunkSize).Rate(opts.Rate),
+		DryRun:   opts.DryRun,
+	}
+
+	if len(resp.Records) == 0 {
+		return summary, nil
+	}
+
+	services := hasColumns(resp.Columns, "host_name", "description")
+	if !services && !hasColumns(resp.Columns, "name") {
+		return nil, fmt.Errorf("query must return the name column for hosts or the host_name and description " +
+			"columns for services")
+	}
+
+	for _, r := range resp.Records {
+		if services {
+			rr := r.Reader()
+			svc := Service{HostName: rr.String("host_name"), Description: rr.String("description")}
+			if err := rr.Err(); err != nil {
+				return nil, err
+			}
+
+			summary.Services = append(summary.Services, svc)
+			summary.Batch.Add(AcknowledgeSvcProblem(svc.HostName, svc.Description, opts.Sticky, opts.Notify,
+				opts.Persistent, opts.Author, opts.Comment))
+		} else {
+			host, err := r.GetString("name")
+			if err != nil {
+				return nil, err
+			}
+
+			summary.Hosts = append(summary.Hosts, host)
+			summary.Batch.Add(AcknowledgeHostProblem(host, opts.Sticky, opts.Notify, opts.Persistent, opts.Author,
+				opts.Comment))
+		}
+	}
+
+	if err := summary.Batch.Err(); err != nil {
+		return nil, err
+	} else if opts.DryRun {
+		return summary, nil
+	}
+
+	_, err = c.Exec(summary.Batch)
+
+	return summary, err
+}
+
+func hasColumns(columns []string, names ...string) bool {
+	for _, name := range names {
+		found := false
+		for _, column := range columns {
+			if column == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
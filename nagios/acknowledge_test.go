@@ -0,0 +1,139 @@
+package nagios
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+func Test_AcknowledgeProblems(t *testing.T) {
+	core := newTestCore(`[["host1","svc1"],["host1","svc2"],["host2","svc1"]]`)
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	summary, err := AcknowledgeProblems(c, UnhandledServiceProblems().Filter("host_name ~ ^host"), AckOptions{
+		Sticky:  AckStickyUntilOK,
+		Notify:  true,
+		Author:  "author1",
+		Comment: "comment1",
+	})
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	// Commands having no response, wait for a query sent on the same connection to ensure they are processed
+	if _, err := c.Exec(livestatus.NewQuery("status")); err != nil {
+		t.Fatal(err)
+	}
+
+	core.mu.Lock()
+	defer core.mu.Unlock()
+
+	expected := []string{
+		"ACKNOWLEDGE_SVC_PROBLEM;host1;svc1;2;1;0;author1;comment1",
+		"ACKNOWLEDGE_SVC_PROBLEM;host1;svc2;2;1;0;author1;comment1",
+		"ACKNOWLEDGE_SVC_PROBLEM;host2;svc1;2;1;0;author1;comment1",
+	}
+	if !reflect.DeepEqual(core.commands, expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, core.commands)
+		t.Fail()
+	}
+
+	if result := summary.String(); result != "0 hosts and 3 services on 2 hosts acknowledged" {
+		t.Logf("\nExpected %q\nbut got  %q\n", "0 hosts and 3 services on 2 hosts acknowledged", result)
+		t.Fail()
+	}
+}
+
+func Test_AcknowledgeProblemsDryRun(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		if !strings.Contains(req, "Filter: scheduled_downtime_depth = 0\n") {
+			return `[]`
+		}
+		return `[["host1"],["host2"]]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	summary, err := AcknowledgeProblems(c, UnhandledHostProblems(), AckOptions{
+		Author:  "author1",
+		Comment: "comment1",
+		DryRun:  true,
+	})
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if len(core.commands) != 0 {
+		t.Logf("\nExpected no command\nbut got  %q\n", core.commands)
+		t.Fail()
+	}
+
+	if expected := []string{"host1", "host2"}; !reflect.DeepEqual(summary.Hosts, expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, summary.Hosts)
+		t.Fail()
+	}
+
+	for _, cmd := range summary.Batch.Commands() {
+		cmd.Timestamp(time.Unix(1439633040, 0))
+	}
+
+	expected := "COMMAND [1439633040] ACKNOWLEDGE_HOST_PROBLEM;host1;0;0;0;author1;comment1\n\n" +
+		"COMMAND [1439633040] ACKNOWLEDGE_HOST_PROBLEM;host2;0;0;0;author1;comment1\n\n"
+	if result := summary.Batch.String(); result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+
+	if result := summary.String(); result != "2 hosts and 0 services on 0 hosts to acknowledge" {
+		t.Logf("\nExpected %q\nbut got  %q\n", "2 hosts and 0 services on 0 hosts to acknowledge", result)
+		t.Fail()
+	}
+}
+
+func Test_AcknowledgeProblemsInvalid(t *testing.T) {
+	core := newTestCore(`[["host1",0]]`)
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	if _, err := AcknowledgeProblems(c, livestatus.NewQuery("hosts").Columns("alias", "state"),
+		AckOptions{}); err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+
+	if _, err := AcknowledgeProblems(c, livestatus.NewQuery("hosts").Columns("name", "state"),
+		AckOptions{Author: "author;1"}); err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+
+	// Rows having a malformed column must not be acknowledged with an empty name
+	if _, err := AcknowledgeProblems(c, livestatus.NewQuery("hosts").Columns("state", "name"),
+		AckOptions{}); err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+
+	if len(core.commands) != 0 {
+		t.Logf("\nExpected no command\nbut got  %q\n", core.commands)
+		t.Fail()
+	}
+}
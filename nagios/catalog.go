@@ -0,0 +1,295 @@
+package nagios
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+var (
+	// ErrUnknownCommand represents a command missing from the catalog.
+	ErrUnknownCommand = errors.New("unknown command")
+	// ErrWrongArity represents a command having an unexpected number of arguments.
+	ErrWrongArity = errors.New("wrong number of arguments")
+	// ErrInvalidArgument represents a command argument not matching its specified type.
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
+// ArgType represents the type of a command argument.
+type ArgType int
+
+// Argument types
+const (
+	ArgString ArgType = iota
+	ArgBool
+	ArgInt
+	ArgDuration
+	ArgTime
+	ArgAckSticky
+	ArgNotificationOptions
+	ArgHostState
+	ArgServiceState
+	ArgIntervals
+)
+
+func (t ArgType) String() string {
+	switch t {
+	case ArgString:
+		return "string"
+	case ArgBool:
+		return "bool"
+	case ArgInt:
+		return "int"
+	case ArgDuration:
+		return "duration"
+	case ArgTime:
+		return "time"
+	case ArgAckSticky:
+		return "acknowledgement stickiness"
+	case ArgNotificationOptions:
+		return "notification options"
+	case ArgHostState:
+		return "host state"
+	case ArgServiceState:
+		return "service state"
+	case ArgIntervals:
+		return "intervals"
+	}
+
+	return fmt.Sprintf("ArgType(%d)", int(t))
+}
+
+// ArgSpec represents the specification of a command argument.
+type ArgSpec struct {
+	Name     string
+	Type     ArgType
+	Required bool
+	// Optional arguments can be left out, only the last argument being allowed to be optional.
+	Optional bool
+}
+
+// CommandSpec represents the specification of a command.
+type CommandSpec struct {
+	Name string
+	Args []ArgSpec
+}
+
+// Catalog represents a set of commands specifications, indexed by command name.
+type Catalog map[string]CommandSpec
+
+// Merge returns a new catalog containing the commands of the catalog and of the given ones, the latter taking
+// precedence (e.g. `nagios.Commands.Merge(naemon.Commands)`).
+func (c Catalog) Merge(others ...Catalog) Catalog {
+	out := Catalog{}
+	for _, catalog := range append([]Catalog{c}, others...) {
+		for name, spec := range catalog {
+			out[name] = spec
+		}
+	}
+
+	return out
+}
+
+// Parse parses the text representation of a command, as rendered by livestatus.Command or as found in the
+// monitoring core command file (e.g. `COMMAND [1439633040] NAME;arg1;arg2`). The `COMMAND` keyword and the
+// timestamp are optional, and log messages are accepted (e.g. `[1439633040] EXTERNAL COMMAND: NAME;arg1;arg2`).
+// Optional trailing arguments can be left out.
+func (c Catalog) Parse(s string) (*ParsedCommand, error) {
+	s = strings.TrimRight(s, "\n")
+
+	for _, prefix := range []string{"COMMAND ", "EXTERNAL COMMAND: "} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+
+	pc := &ParsedCommand{}
+
+	if strings.HasPrefix(s, "[") {
+		end := strings.Index(s, "] ")
+		if end == -1 {
+			return nil, fmt.Errorf("invalid command timestamp in %q", s)
+		}
+
+		ts, err := strconv.ParseInt(s[1:end], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid command timestamp in %q: %v", s, err)
+		}
+		pc.Timestamp = time.Unix(ts, 0)
+
+		s = s[end+2:]
+	}
+
+	// Log entries have the message prefix following the timestamp
+	s = strings.TrimPrefix(s, "EXTERNAL COMMAND: ")
+
+	name, rest, hasArgs := strings.Cut(s, ";")
+	pc.Name = name
+
+	spec, ok := c[pc.Name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCommand, pc.Name)
+	}
+
+	// The last argument being allowed to contain separators, split on the first ones only
+	values := []string{}
+	if hasArgs {
+		n := len(spec.Args)
+		if n == 0 {
+			n = -1
+		}
+		values = strings.SplitN(rest, ";", n)
+	}
+
+	args := spec.Args
+	if n := len(args); n > 0 && args[n-1].Optional {
+		if len(values) == n {
+			// Values not matching the optional argument type belong to the previous string argument
+			if _, err := parseArg(args[n-1], values[n-1]); err != nil && n > 1 && args[n-2].Type == ArgString {
+				values = append(values[:n-2], values[n-2]+";"+values[n-1])
+			}
+		}
+
+		if len(values) < n {
+			args = args[:n-1]
+		}
+	}
+
+	if len(values) != len(args) {
+		return nil, fmt.Errorf("%s: %w: expected %d but got %d", pc.Name, ErrWrongArity, len(args), len(values))
+	}
+
+	for i, as := range args {
+		v, err := parseArg(as, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: argument %q: %w", pc.Name, as.Name, err)
+		}
+
+		pc.Args = append(pc.Args, ParsedArg{
+			Name:  as.Name,
+			Type:  as.Type,
+			Raw:   values[i],
+			Value: v,
+		})
+	}
+
+	return pc, nil
+}
+
+// ParseCommand parses the text representation of a Nagios command using the Commands catalog.
+func ParseCommand(s string) (*ParsedCommand, error) {
+	return Commands.Parse(s)
+}
+
+// ParsedCommand represents a parsed command.
+type ParsedCommand struct {
+	// Timestamp is the command timestamp, zero if not present in the parsed text.
+	Timestamp time.Time
+	Name      string
+	Args      []ParsedArg
+}
+
+// ParsedArg represents a parsed command argument.
+type ParsedArg struct {
+	Name string
+	Type ArgType
+	// Raw is the argument text.
+	Raw string
+	// Value is the typed argument value: string, bool, int64, time.Duration, time.Time, AckSticky,
+	// NotificationOptions, HostState, ServiceState or Intervals depending on its type.
+	Value interface{}
+}
+
+// Arg returns the typed value of a given argument, or nil if the command has no such argument.
+func (pc *ParsedCommand) Arg(name string) interface{} {
+	for _, a := range pc.Args {
+		if a.Name == name {
+			return a.Value
+		}
+	}
+
+	return nil
+}
+
+// Command returns a command rendering the same text as the parsed one.
+func (pc *ParsedCommand) Command() *livestatus.Command {
+	cmd := livestatus.NewCommand(pc.Name)
+	for _, a := range pc.Args {
+		cmd.Arg(a.Raw)
+	}
+
+	if !pc.Timestamp.IsZero() {
+		cmd.Timestamp(pc.Timestamp)
+	}
+
+	return cmd
+}
+
+func parseArg(as ArgSpec, s string) (interface{}, error) {
+	if s == "" {
+		if as.Required {
+			return nil, livestatus.ErrEmptyArgument
+		} else if as.Type == ArgString {
+			return s, nil
+		}
+	}
+
+	switch as.Type {
+	case ArgBool:
+		switch s {
+		case "0":
+			return false, nil
+		case "1":
+			return true, nil
+		}
+
+	case ArgInt:
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, nil
+		}
+
+	case ArgDuration:
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Duration(n) * time.Second, nil
+		}
+
+	case ArgTime:
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.Unix(n, 0), nil
+		}
+
+	case ArgAckSticky:
+		// Both 0 and 1 stand for non-sticky acknowledgements
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 && n <= int(AckStickyUntilOK) {
+			return AckSticky(n), nil
+		}
+
+	case ArgNotificationOptions:
+		all := NotificationBroadcast | NotificationForced | NotificationIncrement
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 && NotificationOptions(n)&^all == 0 {
+			return NotificationOptions(n), nil
+		}
+
+	case ArgHostState:
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 && n < len(hostStateNames) {
+			return HostState(n), nil
+		}
+
+	case ArgServiceState:
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 && n < len(serviceStateNames) {
+			return ServiceState(n), nil
+		}
+
+	case ArgIntervals:
+		if f, err := strconv.ParseFloat(s, 64); err == nil && f >= 0 {
+			return Intervals(f), nil
+		}
+
+	default:
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q is not a valid %s", ErrInvalidArgument, s, as.Type)
+}
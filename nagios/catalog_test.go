@@ -0,0 +1,232 @@
+package nagios
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+func Test_ParseCommand(t *testing.T) {
+	pc, err := ParseCommand("COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;host1;svc1;2;1;0;author1;comment;with;separators\n")
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if !pc.Timestamp.Equal(time.Unix(1439633040, 0)) {
+		t.Logf("\nExpected %s\nbut got  %s\n", time.Unix(1439633040, 0), pc.Timestamp)
+		t.Fail()
+	}
+
+	if pc.Name != "ACKNOWLEDGE_SVC_PROBLEM" {
+		t.Logf("\nExpected %q\nbut got  %q\n", "ACKNOWLEDGE_SVC_PROBLEM", pc.Name)
+		t.Fail()
+	}
+
+	for name, expected := range map[string]interface{}{
+		"host_name":           "host1",
+		"service_description": "svc1",
+		"sticky":              AckStickyUntilOK,
+		"notify":              true,
+		"persistent":          false,
+		"author":              "author1",
+		"comment":             "comment;with;separators",
+	} {
+		if result := pc.Arg(name); result != expected {
+			t.Logf("\nExpected %#v for %q\nbut got  %#v\n", expected, name, result)
+			t.Fail()
+		}
+	}
+
+	if result := pc.Arg("unknown"); result != nil {
+		t.Logf("\nExpected nil\nbut got  %#v\n", result)
+		t.Fail()
+	}
+}
+
+func Test_ParseCommandTypes(t *testing.T) {
+	pc, err := ParseCommand("EXTERNAL COMMAND: SCHEDULE_HOST_DOWNTIME;host1;1439633040;1439636640;1;0;3600;author1;comment1")
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if !pc.Timestamp.IsZero() {
+		t.Logf("\nExpected zero timestamp\nbut got  %s\n", pc.Timestamp)
+		t.Fail()
+	}
+
+	for name, expected := range map[string]interface{}{
+		"start_time": time.Unix(1439633040, 0),
+		"end_time":   time.Unix(1439636640, 0),
+		"fixed":      true,
+		"trigger_id": int64(0),
+		"duration":   time.Hour,
+	} {
+		result := pc.Arg(name)
+		if ts, ok := expected.(time.Time); ok {
+			if rts, ok := result.(time.Time); !ok || !rts.Equal(ts) {
+				t.Logf("\nExpected %#v for %q\nbut got  %#v\n", expected, name, result)
+				t.Fail()
+			}
+		} else if result != expected {
+			t.Logf("\nExpected %#v for %q\nbut got  %#v\n", expected, name, result)
+			t.Fail()
+		}
+	}
+}
+
+func Test_ParseCommandEnums(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		name     string
+		expected interface{}
+	}{
+		{"COMMAND [1439633040] ACKNOWLEDGE_HOST_PROBLEM;host1;1;1;0;author1;comment1", "sticky", AckSticky(1)},
+		{"COMMAND [1439633040] PROCESS_HOST_CHECK_RESULT;host1;2;output1", "status_code", HostUnreachable},
+		{"COMMAND [1439633040] PROCESS_SERVICE_CHECK_RESULT;host1;svc1;1;output1", "return_code", ServiceWarning},
+		{"COMMAND [1439633040] SEND_CUSTOM_HOST_NOTIFICATION;host1;5;author1;comment1", "options",
+			NotificationBroadcast | NotificationIncrement},
+		{"COMMAND [1439633040] CHANGE_NORMAL_SVC_CHECK_INTERVAL;host1;svc1;2.5", "check_interval", Intervals(2.5)},
+	} {
+		pc, err := ParseCommand(test.input)
+		if err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if result := pc.Arg(test.name); result != test.expected {
+			t.Logf("\nExpected %#v for %q\nbut got  %#v\n", test.expected, test.name, result)
+			t.Fail()
+		}
+	}
+}
+
+func Test_ParseCommandLogLine(t *testing.T) {
+	pc, err := ParseCommand("[1439633040] EXTERNAL COMMAND: ACKNOWLEDGE_SVC_PROBLEM;host1;svc1;2;1;1;author1;comment1")
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if !pc.Timestamp.Equal(time.Unix(1439633040, 0)) {
+		t.Logf("\nExpected %s\nbut got  %s\n", time.Unix(1439633040, 0), pc.Timestamp)
+		t.Fail()
+	}
+
+	if pc.Name != "ACKNOWLEDGE_SVC_PROBLEM" || pc.Arg("service_description") != "svc1" {
+		t.Logf("\nUnexpected command %#v\n", pc)
+		t.Fail()
+	}
+}
+
+func Test_ParseCommandErrors(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		expected error
+	}{
+		{"COMMAND [1439633040] UNKNOWN_COMMAND;arg1", ErrUnknownCommand},
+		{"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;host1;svc1;2", ErrWrongArity},
+		{"COMMAND [1439633040] ENABLE_NOTIFICATIONS;arg1", ErrWrongArity},
+		{"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;host1;svc1;yes;1;0;author1;comment1", ErrInvalidArgument},
+		{"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;host1;svc1;2;2;0;author1;comment1", ErrInvalidArgument},
+		{"COMMAND [1439633040] PROCESS_HOST_CHECK_RESULT;host1;3;output1", ErrInvalidArgument},
+		{"COMMAND [1439633040] PROCESS_SERVICE_CHECK_RESULT;host1;svc1;4;output1", ErrInvalidArgument},
+		{"COMMAND [1439633040] SEND_CUSTOM_SVC_NOTIFICATION;host1;svc1;8;author1;comment1", ErrInvalidArgument},
+		{"COMMAND [1439633040] SCHEDULE_HOST_DOWNTIME;host1;now;1439636640;1;0;3600;author1;comment1",
+			ErrInvalidArgument},
+		{"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;;svc1;2;1;0;author1;comment1", livestatus.ErrEmptyArgument},
+	} {
+		if _, err := ParseCommand(test.input); !errors.Is(err, test.expected) {
+			t.Logf("\nExpected %#v for %q\nbut got  %#v\n", test.expected, test.input, err)
+			t.Fail()
+		}
+	}
+
+	if _, err := ParseCommand("COMMAND [now] ENABLE_NOTIFICATIONS"); err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+}
+
+func Test_ParseCommandRoundTrip(t *testing.T) {
+	cmd := AcknowledgeSvcProblem("host1", "svc1", AckStickyUntilOK, false, true, "author1", "comment;1").
+		Timestamp(time.Unix(1439633040, 0))
+
+	expected := cmd.String()
+
+	pc, err := ParseCommand(expected)
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if result := pc.Command().String(); result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_CatalogMerge(t *testing.T) {
+	catalog := Commands.Merge(Catalog{
+		"DEL_DOWNTIME_BY_HOST_NAME": {
+			Name: "DEL_DOWNTIME_BY_HOST_NAME",
+			Args: []ArgSpec{{Name: "host_name", Type: ArgString, Required: true}},
+		},
+	})
+
+	if len(catalog) != len(Commands)+1 {
+		t.Logf("\nExpected %d\nbut got  %d\n", len(Commands)+1, len(catalog))
+		t.Fail()
+	}
+
+	if _, err := catalog.Parse("COMMAND [1439633040] DEL_DOWNTIME_BY_HOST_NAME;host1"); err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.Fail()
+	}
+
+	if _, err := Commands.Parse("COMMAND [1439633040] DEL_DOWNTIME_BY_HOST_NAME;host1"); !errors.Is(err,
+		ErrUnknownCommand) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrUnknownCommand, err)
+		t.Fail()
+	}
+}
+
+func Test_ParseCommandOptional(t *testing.T) {
+	spec := Commands["ACKNOWLEDGE_HOST_PROBLEM"]
+	spec.Args = append(append([]ArgSpec{}, spec.Args...), ArgSpec{Name: "expire_time", Type: ArgTime, Optional: true})
+
+	catalog := Commands.Merge(Catalog{spec.Name: spec})
+
+	for _, test := range []struct {
+		text    string
+		comment string
+		expire  interface{}
+	}{
+		{"ACKNOWLEDGE_HOST_PROBLEM;host1;2;1;0;author1;comment1;1439636640", "comment1", time.Unix(1439636640, 0)},
+		{"ACKNOWLEDGE_HOST_PROBLEM;host1;2;1;0;author1;comment1", "comment1", nil},
+		{"ACKNOWLEDGE_HOST_PROBLEM;host1;2;1;0;author1;comment;with;separators", "comment;with;separators", nil},
+	} {
+		pc, err := catalog.Parse(test.text)
+		if err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+			continue
+		}
+
+		if result := pc.Arg("comment"); result != test.comment {
+			t.Logf("\nExpected %q\nbut got  %#v\n", test.comment, result)
+			t.Fail()
+		}
+
+		if result := pc.Arg("expire_time"); result != test.expire {
+			t.Logf("\nExpected %#v\nbut got  %#v\n", test.expire, result)
+			t.Fail()
+		}
+	}
+
+	if _, err := catalog.Parse("ACKNOWLEDGE_HOST_PROBLEM;host1;2;1;0;author1"); !errors.Is(err, ErrWrongArity) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrWrongArity, err)
+		t.Fail()
+	}
+}
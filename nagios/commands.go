@@ -27,21 +27,19 @@ import (
 // If not, the comment will be deleted the next time Nagios restarts.
 func AcknowledgeHostProblem(
 	host_name string,
-	sticky bool,
+	sticky AckSticky,
 	notify bool,
 	persistent bool,
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ACKNOWLEDGE_HOST_PROBLEM",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("sticky", "bool", sticky),
-		stringifyArg("notify", "bool", notify),
-		stringifyArg("persistent", "bool", persistent),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("ACKNOWLEDGE_HOST_PROBLEM").
+		RequiredArg(host_name).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(author).
+		Arg(comment)
 }
 
 // AcknowledgeSvcProblem creates a new "ACKNOWLEDGE_SVC_PROBLEM" Nagios command.
@@ -64,22 +62,20 @@ func AcknowledgeHostProblem(
 func AcknowledgeSvcProblem(
 	host_name string,
 	service_description string,
-	sticky bool,
+	sticky AckSticky,
 	notify bool,
 	persistent bool,
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ACKNOWLEDGE_SVC_PROBLEM",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("sticky", "bool", sticky),
-		stringifyArg("notify", "bool", notify),
-		stringifyArg("persistent", "bool", persistent),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("ACKNOWLEDGE_SVC_PROBLEM").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(int(sticky)).
+		Arg(notify).
+		Arg(persistent).
+		Arg(author).
+		Arg(comment)
 }
 
 // AddHostComment creates a new "ADD_HOST_COMMENT" Nagios command.
@@ -95,13 +91,11 @@ func AddHostComment(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ADD_HOST_COMMENT",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("persistent", "bool", persistent),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("ADD_HOST_COMMENT").
+		RequiredArg(host_name).
+		Arg(persistent).
+		Arg(author).
+		Arg(comment)
 }
 
 // AddSvcComment creates a new "ADD_SVC_COMMENT" Nagios command.
@@ -118,14 +112,12 @@ func AddSvcComment(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ADD_SVC_COMMENT",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("persistent", "bool", persistent),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("ADD_SVC_COMMENT").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(persistent).
+		Arg(author).
+		Arg(comment)
 }
 
 // ChangeContactHostNotificationTimeperiod creates a new "CHANGE_CONTACT_HOST_NOTIFICATION_TIMEPERIOD" Nagios command.
@@ -141,11 +133,9 @@ func ChangeContactHostNotificationTimeperiod(
 	contact_name string,
 	notification_timeperiod string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CONTACT_HOST_NOTIFICATION_TIMEPERIOD",
-		stringifyArg("contact_name", "string", contact_name),
-		stringifyArg("notification_timeperiod", "string", notification_timeperiod),
-	)
+	return livestatus.NewCommand("CHANGE_CONTACT_HOST_NOTIFICATION_TIMEPERIOD").
+		RequiredArg(contact_name).
+		Arg(notification_timeperiod)
 }
 
 // ChangeContactModattr creates a new "CHANGE_CONTACT_MODATTR" Nagios command.
@@ -163,11 +153,9 @@ func ChangeContactModattr(
 	contact_name string,
 	value string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CONTACT_MODATTR",
-		stringifyArg("contact_name", "string", contact_name),
-		stringifyArg("value", "string", value),
-	)
+	return livestatus.NewCommand("CHANGE_CONTACT_MODATTR").
+		RequiredArg(contact_name).
+		Arg(value)
 }
 
 // ChangeContactModhattr creates a new "CHANGE_CONTACT_MODHATTR" Nagios command.
@@ -185,11 +173,9 @@ func ChangeContactModhattr(
 	contact_name string,
 	value string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CONTACT_MODHATTR",
-		stringifyArg("contact_name", "string", contact_name),
-		stringifyArg("value", "string", value),
-	)
+	return livestatus.NewCommand("CHANGE_CONTACT_MODHATTR").
+		RequiredArg(contact_name).
+		Arg(value)
 }
 
 // ChangeContactModsattr creates a new "CHANGE_CONTACT_MODSATTR" Nagios command.
@@ -207,11 +193,9 @@ func ChangeContactModsattr(
 	contact_name string,
 	value string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CONTACT_MODSATTR",
-		stringifyArg("contact_name", "string", contact_name),
-		stringifyArg("value", "string", value),
-	)
+	return livestatus.NewCommand("CHANGE_CONTACT_MODSATTR").
+		RequiredArg(contact_name).
+		Arg(value)
 }
 
 // ChangeContactSvcNotificationTimeperiod creates a new "CHANGE_CONTACT_SVC_NOTIFICATION_TIMEPERIOD" Nagios command.
@@ -227,11 +211,9 @@ func ChangeContactSvcNotificationTimeperiod(
 	contact_name string,
 	notification_timeperiod string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CONTACT_SVC_NOTIFICATION_TIMEPERIOD",
-		stringifyArg("contact_name", "string", contact_name),
-		stringifyArg("notification_timeperiod", "string", notification_timeperiod),
-	)
+	return livestatus.NewCommand("CHANGE_CONTACT_SVC_NOTIFICATION_TIMEPERIOD").
+		RequiredArg(contact_name).
+		Arg(notification_timeperiod)
 }
 
 // ChangeCustomContactVar creates a new "CHANGE_CUSTOM_CONTACT_VAR" Nagios command.
@@ -242,12 +224,10 @@ func ChangeCustomContactVar(
 	varname string,
 	varvalue string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CUSTOM_CONTACT_VAR",
-		stringifyArg("contact_name", "string", contact_name),
-		stringifyArg("varname", "string", varname),
-		stringifyArg("varvalue", "string", varvalue),
-	)
+	return livestatus.NewCommand("CHANGE_CUSTOM_CONTACT_VAR").
+		RequiredArg(contact_name).
+		Arg(varname).
+		Arg(varvalue)
 }
 
 // ChangeCustomHostVar creates a new "CHANGE_CUSTOM_HOST_VAR" Nagios command.
@@ -258,12 +238,10 @@ func ChangeCustomHostVar(
 	varname string,
 	varvalue string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CUSTOM_HOST_VAR",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("varname", "string", varname),
-		stringifyArg("varvalue", "string", varvalue),
-	)
+	return livestatus.NewCommand("CHANGE_CUSTOM_HOST_VAR").
+		RequiredArg(host_name).
+		Arg(varname).
+		Arg(varvalue)
 }
 
 // ChangeCustomSvcVar creates a new "CHANGE_CUSTOM_SVC_VAR" Nagios command.
@@ -275,13 +253,11 @@ func ChangeCustomSvcVar(
 	varname string,
 	varvalue string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_CUSTOM_SVC_VAR",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("varname", "string", varname),
-		stringifyArg("varvalue", "string", varvalue),
-	)
+	return livestatus.NewCommand("CHANGE_CUSTOM_SVC_VAR").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(varname).
+		Arg(varvalue)
 }
 
 // ChangeGlobalHostEventHandler creates a new "CHANGE_GLOBAL_HOST_EVENT_HANDLER" Nagios command.
@@ -295,10 +271,8 @@ func ChangeCustomSvcVar(
 func ChangeGlobalHostEventHandler(
 	event_handler_command string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_GLOBAL_HOST_EVENT_HANDLER",
-		stringifyArg("event_handler_command", "string", event_handler_command),
-	)
+	return livestatus.NewCommand("CHANGE_GLOBAL_HOST_EVENT_HANDLER").
+		Arg(event_handler_command)
 }
 
 // ChangeGlobalSvcEventHandler creates a new "CHANGE_GLOBAL_SVC_EVENT_HANDLER" Nagios command.
@@ -312,10 +286,8 @@ func ChangeGlobalHostEventHandler(
 func ChangeGlobalSvcEventHandler(
 	event_handler_command string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_GLOBAL_SVC_EVENT_HANDLER",
-		stringifyArg("event_handler_command", "string", event_handler_command),
-	)
+	return livestatus.NewCommand("CHANGE_GLOBAL_SVC_EVENT_HANDLER").
+		Arg(event_handler_command)
 }
 
 // ChangeHostCheckCommand creates a new "CHANGE_HOST_CHECK_COMMAND" Nagios command.
@@ -330,11 +302,9 @@ func ChangeHostCheckCommand(
 	host_name string,
 	check_command string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_HOST_CHECK_COMMAND",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("check_command", "string", check_command),
-	)
+	return livestatus.NewCommand("CHANGE_HOST_CHECK_COMMAND").
+		RequiredArg(host_name).
+		Arg(check_command)
 }
 
 // ChangeHostCheckTimeperiod creates a new "CHANGE_HOST_CHECK_TIMEPERIOD" Nagios command.
@@ -344,11 +314,9 @@ func ChangeHostCheckTimeperiod(
 	host_name string,
 	timeperiod string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_HOST_CHECK_TIMEPERIOD",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("timeperiod", "string", timeperiod),
-	)
+	return livestatus.NewCommand("CHANGE_HOST_CHECK_TIMEPERIOD").
+		RequiredArg(host_name).
+		Arg(timeperiod)
 }
 
 // ChangeHostEventHandler creates a new "CHANGE_HOST_EVENT_HANDLER" Nagios command.
@@ -363,11 +331,9 @@ func ChangeHostEventHandler(
 	host_name string,
 	event_handler_command string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_HOST_EVENT_HANDLER",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("event_handler_command", "string", event_handler_command),
-	)
+	return livestatus.NewCommand("CHANGE_HOST_EVENT_HANDLER").
+		RequiredArg(host_name).
+		Arg(event_handler_command)
 }
 
 // ChangeHostModattr creates a new "CHANGE_HOST_MODATTR" Nagios command.
@@ -385,11 +351,27 @@ func ChangeHostModattr(
 	host_name string,
 	value string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_HOST_MODATTR",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("value", "string", value),
-	)
+	return livestatus.NewCommand("CHANGE_HOST_MODATTR").
+		RequiredArg(host_name).
+		Arg(value)
+}
+
+// ChangeHostNotificationTimeperiod creates a new "CHANGE_HOST_NOTIFICATION_TIMEPERIOD" Nagios command.
+//
+// Changes the notification timeperiod for a particular host to what is specified by the "notification_timeperiod"
+// option.
+//
+// The "notification_timeperiod" option should be the short name of the timeperiod that is to be used as the host
+// notification timeperiod.
+//
+// The timeperiod must have been configured in Nagios before it was last (re)started.
+func ChangeHostNotificationTimeperiod(
+	host_name string,
+	notification_timeperiod string,
+) *livestatus.Command {
+	return livestatus.NewCommand("CHANGE_HOST_NOTIFICATION_TIMEPERIOD").
+		RequiredArg(host_name).
+		Arg(notification_timeperiod)
 }
 
 // ChangeMaxHostCheckAttempts creates a new "CHANGE_MAX_HOST_CHECK_ATTEMPTS" Nagios command.
@@ -399,11 +381,9 @@ func ChangeMaxHostCheckAttempts(
 	host_name string,
 	check_attempts int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_MAX_HOST_CHECK_ATTEMPTS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("check_attempts", "int", check_attempts),
-	)
+	return livestatus.NewCommand("CHANGE_MAX_HOST_CHECK_ATTEMPTS").
+		RequiredArg(host_name).
+		Arg(check_attempts)
 }
 
 // ChangeMaxSvcCheckAttempts creates a new "CHANGE_MAX_SVC_CHECK_ATTEMPTS" Nagios command.
@@ -414,12 +394,10 @@ func ChangeMaxSvcCheckAttempts(
 	service_description string,
 	check_attempts int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_MAX_SVC_CHECK_ATTEMPTS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_attempts", "int", check_attempts),
-	)
+	return livestatus.NewCommand("CHANGE_MAX_SVC_CHECK_ATTEMPTS").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(check_attempts)
 }
 
 // ChangeNormalHostCheckInterval creates a new "CHANGE_NORMAL_HOST_CHECK_INTERVAL" Nagios command.
@@ -427,13 +405,11 @@ func ChangeMaxSvcCheckAttempts(
 // Changes the normal (regularly scheduled) check interval for a particular host.
 func ChangeNormalHostCheckInterval(
 	host_name string,
-	check_interval time.Duration,
+	check_interval Intervals,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_NORMAL_HOST_CHECK_INTERVAL",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("check_interval", "time.Duration", check_interval),
-	)
+	return livestatus.NewCommand("CHANGE_NORMAL_HOST_CHECK_INTERVAL").
+		RequiredArg(host_name).
+		Arg(float64(check_interval))
 }
 
 // ChangeNormalSvcCheckInterval creates a new "CHANGE_NORMAL_SVC_CHECK_INTERVAL" Nagios command.
@@ -442,14 +418,12 @@ func ChangeNormalHostCheckInterval(
 func ChangeNormalSvcCheckInterval(
 	host_name string,
 	service_description string,
-	check_interval time.Duration,
+	check_interval Intervals,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_NORMAL_SVC_CHECK_INTERVAL",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_interval", "time.Duration", check_interval),
-	)
+	return livestatus.NewCommand("CHANGE_NORMAL_SVC_CHECK_INTERVAL").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(float64(check_interval))
 }
 
 // ChangeRetryHostCheckInterval creates a new "CHANGE_RETRY_HOST_CHECK_INTERVAL" Nagios command.
@@ -458,14 +432,12 @@ func ChangeNormalSvcCheckInterval(
 func ChangeRetryHostCheckInterval(
 	host_name string,
 	service_description string,
-	check_interval time.Duration,
+	check_interval Intervals,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_RETRY_HOST_CHECK_INTERVAL",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_interval", "time.Duration", check_interval),
-	)
+	return livestatus.NewCommand("CHANGE_RETRY_HOST_CHECK_INTERVAL").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(float64(check_interval))
 }
 
 // ChangeRetrySvcCheckInterval creates a new "CHANGE_RETRY_SVC_CHECK_INTERVAL" Nagios command.
@@ -474,14 +446,12 @@ func ChangeRetryHostCheckInterval(
 func ChangeRetrySvcCheckInterval(
 	host_name string,
 	service_description string,
-	check_interval time.Duration,
+	check_interval Intervals,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_RETRY_SVC_CHECK_INTERVAL",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_interval", "time.Duration", check_interval),
-	)
+	return livestatus.NewCommand("CHANGE_RETRY_SVC_CHECK_INTERVAL").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(float64(check_interval))
 }
 
 // ChangeSvcCheckCommand creates a new "CHANGE_SVC_CHECK_COMMAND" Nagios command.
@@ -497,12 +467,10 @@ func ChangeSvcCheckCommand(
 	service_description string,
 	check_command string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_SVC_CHECK_COMMAND",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_command", "string", check_command),
-	)
+	return livestatus.NewCommand("CHANGE_SVC_CHECK_COMMAND").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(check_command)
 }
 
 // ChangeSvcCheckTimeperiod creates a new "CHANGE_SVC_CHECK_TIMEPERIOD" Nagios command.
@@ -518,12 +486,10 @@ func ChangeSvcCheckTimeperiod(
 	service_description string,
 	check_timeperiod string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_SVC_CHECK_TIMEPERIOD",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_timeperiod", "string", check_timeperiod),
-	)
+	return livestatus.NewCommand("CHANGE_SVC_CHECK_TIMEPERIOD").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(check_timeperiod)
 }
 
 // ChangeSvcEventHandler creates a new "CHANGE_SVC_EVENT_HANDLER" Nagios command.
@@ -540,12 +506,10 @@ func ChangeSvcEventHandler(
 	service_description string,
 	event_handler_command string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_SVC_EVENT_HANDLER",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("event_handler_command", "string", event_handler_command),
-	)
+	return livestatus.NewCommand("CHANGE_SVC_EVENT_HANDLER").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(event_handler_command)
 }
 
 // ChangeSvcModattr creates a new "CHANGE_SVC_MODATTR" Nagios command.
@@ -564,12 +528,10 @@ func ChangeSvcModattr(
 	service_description string,
 	value string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_SVC_MODATTR",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("value", "string", value),
-	)
+	return livestatus.NewCommand("CHANGE_SVC_MODATTR").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(value)
 }
 
 // ChangeSvcNotificationTimeperiod creates a new "CHANGE_SVC_NOTIFICATION_TIMEPERIOD" Nagios command.
@@ -586,12 +548,10 @@ func ChangeSvcNotificationTimeperiod(
 	service_description string,
 	notification_timeperiod string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"CHANGE_SVC_NOTIFICATION_TIMEPERIOD",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("notification_timeperiod", "string", notification_timeperiod),
-	)
+	return livestatus.NewCommand("CHANGE_SVC_NOTIFICATION_TIMEPERIOD").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(notification_timeperiod)
 }
 
 // DelayHostNotification creates a new "DELAY_HOST_NOTIFICATION" Nagios command.
@@ -608,11 +568,9 @@ func DelayHostNotification(
 	host_name string,
 	notification_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DELAY_HOST_NOTIFICATION",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("notification_time", "time.Time", notification_time),
-	)
+	return livestatus.NewCommand("DELAY_HOST_NOTIFICATION").
+		RequiredArg(host_name).
+		Arg(notification_time)
 }
 
 // DelaySvcNotification creates a new "DELAY_SVC_NOTIFICATION" Nagios command.
@@ -630,12 +588,10 @@ func DelaySvcNotification(
 	service_description string,
 	notification_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DELAY_SVC_NOTIFICATION",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("notification_time", "time.Time", notification_time),
-	)
+	return livestatus.NewCommand("DELAY_SVC_NOTIFICATION").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(notification_time)
 }
 
 // DelAllHostComments creates a new "DEL_ALL_HOST_COMMENTS" Nagios command.
@@ -644,10 +600,8 @@ func DelaySvcNotification(
 func DelAllHostComments(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DEL_ALL_HOST_COMMENTS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DEL_ALL_HOST_COMMENTS").
+		RequiredArg(host_name)
 }
 
 // DelAllSvcComments creates a new "DEL_ALL_SVC_COMMENTS" Nagios command.
@@ -657,11 +611,9 @@ func DelAllSvcComments(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DEL_ALL_SVC_COMMENTS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("DEL_ALL_SVC_COMMENTS").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // DelHostComment creates a new "DEL_HOST_COMMENT" Nagios command.
@@ -672,10 +624,8 @@ func DelAllSvcComments(
 func DelHostComment(
 	comment_id int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DEL_HOST_COMMENT",
-		stringifyArg("comment_id", "int", comment_id),
-	)
+	return livestatus.NewCommand("DEL_HOST_COMMENT").
+		Arg(comment_id)
 }
 
 // DelHostDowntime creates a new "DEL_HOST_DOWNTIME" Nagios command.
@@ -687,10 +637,8 @@ func DelHostComment(
 func DelHostDowntime(
 	downtime_id int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DEL_HOST_DOWNTIME",
-		stringifyArg("downtime_id", "int", downtime_id),
-	)
+	return livestatus.NewCommand("DEL_HOST_DOWNTIME").
+		Arg(downtime_id)
 }
 
 // DelSvcComment creates a new "DEL_SVC_COMMENT" Nagios command.
@@ -701,10 +649,8 @@ func DelHostDowntime(
 func DelSvcComment(
 	comment_id int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DEL_SVC_COMMENT",
-		stringifyArg("comment_id", "int", comment_id),
-	)
+	return livestatus.NewCommand("DEL_SVC_COMMENT").
+		Arg(comment_id)
 }
 
 // DelSvcDowntime creates a new "DEL_SVC_DOWNTIME" Nagios command.
@@ -716,10 +662,8 @@ func DelSvcComment(
 func DelSvcDowntime(
 	downtime_id int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DEL_SVC_DOWNTIME",
-		stringifyArg("downtime_id", "int", downtime_id),
-	)
+	return livestatus.NewCommand("DEL_SVC_DOWNTIME").
+		Arg(downtime_id)
 }
 
 // DisableAllNotificationsBeyondHost creates a new "DISABLE_ALL_NOTIFICATIONS_BEYOND_HOST" Nagios command.
@@ -730,10 +674,8 @@ func DelSvcDowntime(
 func DisableAllNotificationsBeyondHost(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_ALL_NOTIFICATIONS_BEYOND_HOST",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_ALL_NOTIFICATIONS_BEYOND_HOST").
+		RequiredArg(host_name)
 }
 
 // DisableContactgroupHostNotifications creates a new "DISABLE_CONTACTGROUP_HOST_NOTIFICATIONS" Nagios command.
@@ -742,10 +684,8 @@ func DisableAllNotificationsBeyondHost(
 func DisableContactgroupHostNotifications(
 	contactgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_CONTACTGROUP_HOST_NOTIFICATIONS",
-		stringifyArg("contactgroup_name", "string", contactgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_CONTACTGROUP_HOST_NOTIFICATIONS").
+		RequiredArg(contactgroup_name)
 }
 
 // DisableContactgroupSvcNotifications creates a new "DISABLE_CONTACTGROUP_SVC_NOTIFICATIONS" Nagios command.
@@ -754,10 +694,8 @@ func DisableContactgroupHostNotifications(
 func DisableContactgroupSvcNotifications(
 	contactgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_CONTACTGROUP_SVC_NOTIFICATIONS",
-		stringifyArg("contactgroup_name", "string", contactgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_CONTACTGROUP_SVC_NOTIFICATIONS").
+		RequiredArg(contactgroup_name)
 }
 
 // DisableContactHostNotifications creates a new "DISABLE_CONTACT_HOST_NOTIFICATIONS" Nagios command.
@@ -766,10 +704,8 @@ func DisableContactgroupSvcNotifications(
 func DisableContactHostNotifications(
 	contact_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_CONTACT_HOST_NOTIFICATIONS",
-		stringifyArg("contact_name", "string", contact_name),
-	)
+	return livestatus.NewCommand("DISABLE_CONTACT_HOST_NOTIFICATIONS").
+		RequiredArg(contact_name)
 }
 
 // DisableContactSvcNotifications creates a new "DISABLE_CONTACT_SVC_NOTIFICATIONS" Nagios command.
@@ -778,19 +714,15 @@ func DisableContactHostNotifications(
 func DisableContactSvcNotifications(
 	contact_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_CONTACT_SVC_NOTIFICATIONS",
-		stringifyArg("contact_name", "string", contact_name),
-	)
+	return livestatus.NewCommand("DISABLE_CONTACT_SVC_NOTIFICATIONS").
+		RequiredArg(contact_name)
 }
 
 // DisableEventHandlers creates a new "DISABLE_EVENT_HANDLERS" Nagios command.
 //
 // Disables host and service event handlers on a program-wide basis.
 func DisableEventHandlers() *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_EVENT_HANDLERS",
-	)
+	return livestatus.NewCommand("DISABLE_EVENT_HANDLERS")
 }
 
 // DisableFailurePrediction creates a new "DISABLE_FAILURE_PREDICTION" Nagios command.
@@ -799,18 +731,14 @@ func DisableEventHandlers() *livestatus.Command {
 //
 // This feature is not currently implemented in Nagios.
 func DisableFailurePrediction() *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_FAILURE_PREDICTION",
-	)
+	return livestatus.NewCommand("DISABLE_FAILURE_PREDICTION")
 }
 
 // DisableFlapDetection creates a new "DISABLE_FLAP_DETECTION" Nagios command.
 //
 // Disables host and service flap detection on a program-wide basis.
 func DisableFlapDetection() *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_FLAP_DETECTION",
-	)
+	return livestatus.NewCommand("DISABLE_FLAP_DETECTION")
 }
 
 // DisableHostgroupHostChecks creates a new "DISABLE_HOSTGROUP_HOST_CHECKS" Nagios command.
@@ -819,10 +747,8 @@ func DisableFlapDetection() *livestatus.Command {
 func DisableHostgroupHostChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOSTGROUP_HOST_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOSTGROUP_HOST_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // DisableHostgroupHostNotifications creates a new "DISABLE_HOSTGROUP_HOST_NOTIFICATIONS" Nagios command.
@@ -834,10 +760,8 @@ func DisableHostgroupHostChecks(
 func DisableHostgroupHostNotifications(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOSTGROUP_HOST_NOTIFICATIONS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOSTGROUP_HOST_NOTIFICATIONS").
+		RequiredArg(hostgroup_name)
 }
 
 // DisableHostgroupPassiveHostChecks creates a new "DISABLE_HOSTGROUP_PASSIVE_HOST_CHECKS" Nagios command.
@@ -846,10 +770,8 @@ func DisableHostgroupHostNotifications(
 func DisableHostgroupPassiveHostChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOSTGROUP_PASSIVE_HOST_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOSTGROUP_PASSIVE_HOST_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // DisableHostgroupPassiveSvcChecks creates a new "DISABLE_HOSTGROUP_PASSIVE_SVC_CHECKS" Nagios command.
@@ -858,10 +780,8 @@ func DisableHostgroupPassiveHostChecks(
 func DisableHostgroupPassiveSvcChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOSTGROUP_PASSIVE_SVC_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOSTGROUP_PASSIVE_SVC_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // DisableHostgroupSvcChecks creates a new "DISABLE_HOSTGROUP_SVC_CHECKS" Nagios command.
@@ -870,10 +790,8 @@ func DisableHostgroupPassiveSvcChecks(
 func DisableHostgroupSvcChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOSTGROUP_SVC_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOSTGROUP_SVC_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // DisableHostgroupSvcNotifications creates a new "DISABLE_HOSTGROUP_SVC_NOTIFICATIONS" Nagios command.
@@ -885,10 +803,8 @@ func DisableHostgroupSvcChecks(
 func DisableHostgroupSvcNotifications(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOSTGROUP_SVC_NOTIFICATIONS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOSTGROUP_SVC_NOTIFICATIONS").
+		RequiredArg(hostgroup_name)
 }
 
 // DisableHostAndChildNotifications creates a new "DISABLE_HOST_AND_CHILD_NOTIFICATIONS" Nagios command.
@@ -898,10 +814,8 @@ func DisableHostgroupSvcNotifications(
 func DisableHostAndChildNotifications(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_AND_CHILD_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOST_AND_CHILD_NOTIFICATIONS").
+		RequiredArg(host_name)
 }
 
 // DisableHostCheck creates a new "DISABLE_HOST_CHECK" Nagios command.
@@ -910,10 +824,8 @@ func DisableHostAndChildNotifications(
 func DisableHostCheck(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_CHECK",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOST_CHECK").
+		RequiredArg(host_name)
 }
 
 // DisableHostEventHandler creates a new "DISABLE_HOST_EVENT_HANDLER" Nagios command.
@@ -922,10 +834,8 @@ func DisableHostCheck(
 func DisableHostEventHandler(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_EVENT_HANDLER",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOST_EVENT_HANDLER").
+		RequiredArg(host_name)
 }
 
 // DisableHostFlapDetection creates a new "DISABLE_HOST_FLAP_DETECTION" Nagios command.
@@ -934,19 +844,15 @@ func DisableHostEventHandler(
 func DisableHostFlapDetection(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_FLAP_DETECTION",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOST_FLAP_DETECTION").
+		RequiredArg(host_name)
 }
 
 // DisableHostFreshnessChecks creates a new "DISABLE_HOST_FRESHNESS_CHECKS" Nagios command.
 //
 // Disables freshness checks of all hosts on a program-wide basis.
 func DisableHostFreshnessChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_FRESHNESS_CHECKS",
-	)
+	return livestatus.NewCommand("DISABLE_HOST_FRESHNESS_CHECKS")
 }
 
 // DisableHostNotifications creates a new "DISABLE_HOST_NOTIFICATIONS" Nagios command.
@@ -955,10 +861,8 @@ func DisableHostFreshnessChecks() *livestatus.Command {
 func DisableHostNotifications(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOST_NOTIFICATIONS").
+		RequiredArg(host_name)
 }
 
 // DisableHostSvcChecks creates a new "DISABLE_HOST_SVC_CHECKS" Nagios command.
@@ -967,10 +871,8 @@ func DisableHostNotifications(
 func DisableHostSvcChecks(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_SVC_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOST_SVC_CHECKS").
+		RequiredArg(host_name)
 }
 
 // DisableHostSvcNotifications creates a new "DISABLE_HOST_SVC_NOTIFICATIONS" Nagios command.
@@ -979,19 +881,15 @@ func DisableHostSvcChecks(
 func DisableHostSvcNotifications(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_HOST_SVC_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_HOST_SVC_NOTIFICATIONS").
+		RequiredArg(host_name)
 }
 
 // DisableNotifications creates a new "DISABLE_NOTIFICATIONS" Nagios command.
 //
 // Disables host and service notifications on a program-wide basis.
 func DisableNotifications() *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_NOTIFICATIONS",
-	)
+	return livestatus.NewCommand("DISABLE_NOTIFICATIONS")
 }
 
 // DisablePassiveHostChecks creates a new "DISABLE_PASSIVE_HOST_CHECKS" Nagios command.
@@ -1000,10 +898,8 @@ func DisableNotifications() *livestatus.Command {
 func DisablePassiveHostChecks(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_PASSIVE_HOST_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("DISABLE_PASSIVE_HOST_CHECKS").
+		RequiredArg(host_name)
 }
 
 // DisablePassiveSvcChecks creates a new "DISABLE_PASSIVE_SVC_CHECKS" Nagios command.
@@ -1013,20 +909,16 @@ func DisablePassiveSvcChecks(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_PASSIVE_SVC_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("DISABLE_PASSIVE_SVC_CHECKS").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // DisablePerformanceData creates a new "DISABLE_PERFORMANCE_DATA" Nagios command.
 //
 // Disables the processing of host and service performance data on a program-wide basis.
 func DisablePerformanceData() *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_PERFORMANCE_DATA",
-	)
+	return livestatus.NewCommand("DISABLE_PERFORMANCE_DATA")
 }
 
 // DisableServicegroupHostChecks creates a new "DISABLE_SERVICEGROUP_HOST_CHECKS" Nagios command.
@@ -1035,10 +927,8 @@ func DisablePerformanceData() *livestatus.Command {
 func DisableServicegroupHostChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICEGROUP_HOST_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_SERVICEGROUP_HOST_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // DisableServicegroupHostNotifications creates a new "DISABLE_SERVICEGROUP_HOST_NOTIFICATIONS" Nagios command.
@@ -1047,10 +937,8 @@ func DisableServicegroupHostChecks(
 func DisableServicegroupHostNotifications(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICEGROUP_HOST_NOTIFICATIONS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_SERVICEGROUP_HOST_NOTIFICATIONS").
+		RequiredArg(servicegroup_name)
 }
 
 // DisableServicegroupPassiveHostChecks creates a new "DISABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS" Nagios command.
@@ -1060,10 +948,8 @@ func DisableServicegroupHostNotifications(
 func DisableServicegroupPassiveHostChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // DisableServicegroupPassiveSvcChecks creates a new "DISABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS" Nagios command.
@@ -1072,10 +958,8 @@ func DisableServicegroupPassiveHostChecks(
 func DisableServicegroupPassiveSvcChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // DisableServicegroupSvcChecks creates a new "DISABLE_SERVICEGROUP_SVC_CHECKS" Nagios command.
@@ -1084,10 +968,8 @@ func DisableServicegroupPassiveSvcChecks(
 func DisableServicegroupSvcChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICEGROUP_SVC_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_SERVICEGROUP_SVC_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // DisableServicegroupSvcNotifications creates a new "DISABLE_SERVICEGROUP_SVC_NOTIFICATIONS" Nagios command.
@@ -1096,10 +978,8 @@ func DisableServicegroupSvcChecks(
 func DisableServicegroupSvcNotifications(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICEGROUP_SVC_NOTIFICATIONS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("DISABLE_SERVICEGROUP_SVC_NOTIFICATIONS").
+		RequiredArg(servicegroup_name)
 }
 
 // DisableServiceFlapDetection creates a new "DISABLE_SERVICE_FLAP_DETECTION" Nagios command.
@@ -1109,20 +989,16 @@ func DisableServiceFlapDetection(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICE_FLAP_DETECTION",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("DISABLE_SERVICE_FLAP_DETECTION").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // DisableServiceFreshnessChecks creates a new "DISABLE_SERVICE_FRESHNESS_CHECKS" Nagios command.
 //
 // Disables freshness checks of all services on a program-wide basis.
 func DisableServiceFreshnessChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SERVICE_FRESHNESS_CHECKS",
-	)
+	return livestatus.NewCommand("DISABLE_SERVICE_FRESHNESS_CHECKS")
 }
 
 // DisableSvcCheck creates a new "DISABLE_SVC_CHECK" Nagios command.
@@ -1132,11 +1008,9 @@ func DisableSvcCheck(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SVC_CHECK",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("DISABLE_SVC_CHECK").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // DisableSvcEventHandler creates a new "DISABLE_SVC_EVENT_HANDLER" Nagios command.
@@ -1146,11 +1020,9 @@ func DisableSvcEventHandler(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SVC_EVENT_HANDLER",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("DISABLE_SVC_EVENT_HANDLER").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // DisableSvcFlapDetection creates a new "DISABLE_SVC_FLAP_DETECTION" Nagios command.
@@ -1160,11 +1032,9 @@ func DisableSvcFlapDetection(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SVC_FLAP_DETECTION",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("DISABLE_SVC_FLAP_DETECTION").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // DisableSvcNotifications creates a new "DISABLE_SVC_NOTIFICATIONS" Nagios command.
@@ -1174,11 +1044,9 @@ func DisableSvcNotifications(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"DISABLE_SVC_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("DISABLE_SVC_NOTIFICATIONS").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // EnableAllNotificationsBeyondHost creates a new "ENABLE_ALL_NOTIFICATIONS_BEYOND_HOST" Nagios command.
@@ -1192,10 +1060,8 @@ func DisableSvcNotifications(
 func EnableAllNotificationsBeyondHost(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_ALL_NOTIFICATIONS_BEYOND_HOST",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_ALL_NOTIFICATIONS_BEYOND_HOST").
+		RequiredArg(host_name)
 }
 
 // EnableContactgroupHostNotifications creates a new "ENABLE_CONTACTGROUP_HOST_NOTIFICATIONS" Nagios command.
@@ -1204,10 +1070,8 @@ func EnableAllNotificationsBeyondHost(
 func EnableContactgroupHostNotifications(
 	contactgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_CONTACTGROUP_HOST_NOTIFICATIONS",
-		stringifyArg("contactgroup_name", "string", contactgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_CONTACTGROUP_HOST_NOTIFICATIONS").
+		RequiredArg(contactgroup_name)
 }
 
 // EnableContactgroupSvcNotifications creates a new "ENABLE_CONTACTGROUP_SVC_NOTIFICATIONS" Nagios command.
@@ -1216,10 +1080,8 @@ func EnableContactgroupHostNotifications(
 func EnableContactgroupSvcNotifications(
 	contactgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_CONTACTGROUP_SVC_NOTIFICATIONS",
-		stringifyArg("contactgroup_name", "string", contactgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_CONTACTGROUP_SVC_NOTIFICATIONS").
+		RequiredArg(contactgroup_name)
 }
 
 // EnableContactHostNotifications creates a new "ENABLE_CONTACT_HOST_NOTIFICATIONS" Nagios command.
@@ -1228,10 +1090,8 @@ func EnableContactgroupSvcNotifications(
 func EnableContactHostNotifications(
 	contact_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_CONTACT_HOST_NOTIFICATIONS",
-		stringifyArg("contact_name", "string", contact_name),
-	)
+	return livestatus.NewCommand("ENABLE_CONTACT_HOST_NOTIFICATIONS").
+		RequiredArg(contact_name)
 }
 
 // EnableContactSvcNotifications creates a new "ENABLE_CONTACT_SVC_NOTIFICATIONS" Nagios command.
@@ -1240,19 +1100,15 @@ func EnableContactHostNotifications(
 func EnableContactSvcNotifications(
 	contact_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_CONTACT_SVC_NOTIFICATIONS",
-		stringifyArg("contact_name", "string", contact_name),
-	)
+	return livestatus.NewCommand("ENABLE_CONTACT_SVC_NOTIFICATIONS").
+		RequiredArg(contact_name)
 }
 
 // EnableEventHandlers creates a new "ENABLE_EVENT_HANDLERS" Nagios command.
 //
 // Enables host and service event handlers on a program-wide basis.
 func EnableEventHandlers() *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_EVENT_HANDLERS",
-	)
+	return livestatus.NewCommand("ENABLE_EVENT_HANDLERS")
 }
 
 // EnableFailurePrediction creates a new "ENABLE_FAILURE_PREDICTION" Nagios command.
@@ -1261,18 +1117,14 @@ func EnableEventHandlers() *livestatus.Command {
 //
 // This feature is not currently implemented in Nagios.
 func EnableFailurePrediction() *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_FAILURE_PREDICTION",
-	)
+	return livestatus.NewCommand("ENABLE_FAILURE_PREDICTION")
 }
 
 // EnableFlapDetection creates a new "ENABLE_FLAP_DETECTION" Nagios command.
 //
 // Enables host and service flap detection on a program-wide basis.
 func EnableFlapDetection() *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_FLAP_DETECTION",
-	)
+	return livestatus.NewCommand("ENABLE_FLAP_DETECTION")
 }
 
 // EnableHostgroupHostChecks creates a new "ENABLE_HOSTGROUP_HOST_CHECKS" Nagios command.
@@ -1281,10 +1133,8 @@ func EnableFlapDetection() *livestatus.Command {
 func EnableHostgroupHostChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOSTGROUP_HOST_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOSTGROUP_HOST_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // EnableHostgroupHostNotifications creates a new "ENABLE_HOSTGROUP_HOST_NOTIFICATIONS" Nagios command.
@@ -1299,10 +1149,8 @@ func EnableHostgroupHostChecks(
 func EnableHostgroupHostNotifications(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOSTGROUP_HOST_NOTIFICATIONS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOSTGROUP_HOST_NOTIFICATIONS").
+		RequiredArg(hostgroup_name)
 }
 
 // EnableHostgroupPassiveHostChecks creates a new "ENABLE_HOSTGROUP_PASSIVE_HOST_CHECKS" Nagios command.
@@ -1311,10 +1159,8 @@ func EnableHostgroupHostNotifications(
 func EnableHostgroupPassiveHostChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOSTGROUP_PASSIVE_HOST_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOSTGROUP_PASSIVE_HOST_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // EnableHostgroupPassiveSvcChecks creates a new "ENABLE_HOSTGROUP_PASSIVE_SVC_CHECKS" Nagios command.
@@ -1323,10 +1169,8 @@ func EnableHostgroupPassiveHostChecks(
 func EnableHostgroupPassiveSvcChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOSTGROUP_PASSIVE_SVC_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOSTGROUP_PASSIVE_SVC_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // EnableHostgroupSvcChecks creates a new "ENABLE_HOSTGROUP_SVC_CHECKS" Nagios command.
@@ -1335,10 +1179,8 @@ func EnableHostgroupPassiveSvcChecks(
 func EnableHostgroupSvcChecks(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOSTGROUP_SVC_CHECKS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOSTGROUP_SVC_CHECKS").
+		RequiredArg(hostgroup_name)
 }
 
 // EnableHostgroupSvcNotifications creates a new "ENABLE_HOSTGROUP_SVC_NOTIFICATIONS" Nagios command.
@@ -1353,10 +1195,8 @@ func EnableHostgroupSvcChecks(
 func EnableHostgroupSvcNotifications(
 	hostgroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOSTGROUP_SVC_NOTIFICATIONS",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOSTGROUP_SVC_NOTIFICATIONS").
+		RequiredArg(hostgroup_name)
 }
 
 // EnableHostAndChildNotifications creates a new "ENABLE_HOST_AND_CHILD_NOTIFICATIONS" Nagios command.
@@ -1368,10 +1208,8 @@ func EnableHostgroupSvcNotifications(
 func EnableHostAndChildNotifications(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_AND_CHILD_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOST_AND_CHILD_NOTIFICATIONS").
+		RequiredArg(host_name)
 }
 
 // EnableHostCheck creates a new "ENABLE_HOST_CHECK" Nagios command.
@@ -1380,10 +1218,8 @@ func EnableHostAndChildNotifications(
 func EnableHostCheck(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_CHECK",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOST_CHECK").
+		RequiredArg(host_name)
 }
 
 // EnableHostEventHandler creates a new "ENABLE_HOST_EVENT_HANDLER" Nagios command.
@@ -1392,10 +1228,8 @@ func EnableHostCheck(
 func EnableHostEventHandler(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_EVENT_HANDLER",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOST_EVENT_HANDLER").
+		RequiredArg(host_name)
 }
 
 // EnableHostFlapDetection creates a new "ENABLE_HOST_FLAP_DETECTION" Nagios command.
@@ -1407,10 +1241,8 @@ func EnableHostEventHandler(
 func EnableHostFlapDetection(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_FLAP_DETECTION",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOST_FLAP_DETECTION").
+		RequiredArg(host_name)
 }
 
 // EnableHostFreshnessChecks creates a new "ENABLE_HOST_FRESHNESS_CHECKS" Nagios command.
@@ -1419,9 +1251,7 @@ func EnableHostFlapDetection(
 //
 // Individual hosts that have freshness checks disabled will not be checked for freshness.
 func EnableHostFreshnessChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_FRESHNESS_CHECKS",
-	)
+	return livestatus.NewCommand("ENABLE_HOST_FRESHNESS_CHECKS")
 }
 
 // EnableHostNotifications creates a new "ENABLE_HOST_NOTIFICATIONS" Nagios command.
@@ -1432,10 +1262,8 @@ func EnableHostFreshnessChecks() *livestatus.Command {
 func EnableHostNotifications(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOST_NOTIFICATIONS").
+		RequiredArg(host_name)
 }
 
 // EnableHostSvcChecks creates a new "ENABLE_HOST_SVC_CHECKS" Nagios command.
@@ -1444,10 +1272,8 @@ func EnableHostNotifications(
 func EnableHostSvcChecks(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_SVC_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOST_SVC_CHECKS").
+		RequiredArg(host_name)
 }
 
 // EnableHostSvcNotifications creates a new "ENABLE_HOST_SVC_NOTIFICATIONS" Nagios command.
@@ -1458,19 +1284,15 @@ func EnableHostSvcChecks(
 func EnableHostSvcNotifications(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_HOST_SVC_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_HOST_SVC_NOTIFICATIONS").
+		RequiredArg(host_name)
 }
 
 // EnableNotifications creates a new "ENABLE_NOTIFICATIONS" Nagios command.
 //
 // Enables host and service notifications on a program-wide basis.
 func EnableNotifications() *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_NOTIFICATIONS",
-	)
+	return livestatus.NewCommand("ENABLE_NOTIFICATIONS")
 }
 
 // EnablePassiveHostChecks creates a new "ENABLE_PASSIVE_HOST_CHECKS" Nagios command.
@@ -1479,10 +1301,8 @@ func EnableNotifications() *livestatus.Command {
 func EnablePassiveHostChecks(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_PASSIVE_HOST_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("ENABLE_PASSIVE_HOST_CHECKS").
+		RequiredArg(host_name)
 }
 
 // EnablePassiveSvcChecks creates a new "ENABLE_PASSIVE_SVC_CHECKS" Nagios command.
@@ -1492,20 +1312,16 @@ func EnablePassiveSvcChecks(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_PASSIVE_SVC_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("ENABLE_PASSIVE_SVC_CHECKS").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // EnablePerformanceData creates a new "ENABLE_PERFORMANCE_DATA" Nagios command.
 //
 // Enables the processing of host and service performance data on a program-wide basis.
 func EnablePerformanceData() *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_PERFORMANCE_DATA",
-	)
+	return livestatus.NewCommand("ENABLE_PERFORMANCE_DATA")
 }
 
 // EnableServicegroupHostChecks creates a new "ENABLE_SERVICEGROUP_HOST_CHECKS" Nagios command.
@@ -1514,10 +1330,8 @@ func EnablePerformanceData() *livestatus.Command {
 func EnableServicegroupHostChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SERVICEGROUP_HOST_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_SERVICEGROUP_HOST_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // EnableServicegroupHostNotifications creates a new "ENABLE_SERVICEGROUP_HOST_NOTIFICATIONS" Nagios command.
@@ -1529,10 +1343,8 @@ func EnableServicegroupHostChecks(
 func EnableServicegroupHostNotifications(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SERVICEGROUP_HOST_NOTIFICATIONS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_SERVICEGROUP_HOST_NOTIFICATIONS").
+		RequiredArg(servicegroup_name)
 }
 
 // EnableServicegroupPassiveHostChecks creates a new "ENABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS" Nagios command.
@@ -1542,10 +1354,8 @@ func EnableServicegroupHostNotifications(
 func EnableServicegroupPassiveHostChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // EnableServicegroupPassiveSvcChecks creates a new "ENABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS" Nagios command.
@@ -1554,10 +1364,8 @@ func EnableServicegroupPassiveHostChecks(
 func EnableServicegroupPassiveSvcChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // EnableServicegroupSvcChecks creates a new "ENABLE_SERVICEGROUP_SVC_CHECKS" Nagios command.
@@ -1566,10 +1374,8 @@ func EnableServicegroupPassiveSvcChecks(
 func EnableServicegroupSvcChecks(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SERVICEGROUP_SVC_CHECKS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_SERVICEGROUP_SVC_CHECKS").
+		RequiredArg(servicegroup_name)
 }
 
 // EnableServicegroupSvcNotifications creates a new "ENABLE_SERVICEGROUP_SVC_NOTIFICATIONS" Nagios command.
@@ -1581,10 +1387,8 @@ func EnableServicegroupSvcChecks(
 func EnableServicegroupSvcNotifications(
 	servicegroup_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SERVICEGROUP_SVC_NOTIFICATIONS",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-	)
+	return livestatus.NewCommand("ENABLE_SERVICEGROUP_SVC_NOTIFICATIONS").
+		RequiredArg(servicegroup_name)
 }
 
 // EnableServiceFreshnessChecks creates a new "ENABLE_SERVICE_FRESHNESS_CHECKS" Nagios command.
@@ -1593,9 +1397,7 @@ func EnableServicegroupSvcNotifications(
 //
 // Individual services that have freshness checks disabled will not be checked for freshness.
 func EnableServiceFreshnessChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SERVICE_FRESHNESS_CHECKS",
-	)
+	return livestatus.NewCommand("ENABLE_SERVICE_FRESHNESS_CHECKS")
 }
 
 // EnableSvcCheck creates a new "ENABLE_SVC_CHECK" Nagios command.
@@ -1605,11 +1407,9 @@ func EnableSvcCheck(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SVC_CHECK",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("ENABLE_SVC_CHECK").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // EnableSvcEventHandler creates a new "ENABLE_SVC_EVENT_HANDLER" Nagios command.
@@ -1619,11 +1419,9 @@ func EnableSvcEventHandler(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SVC_EVENT_HANDLER",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("ENABLE_SVC_EVENT_HANDLER").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // EnableSvcFlapDetection creates a new "ENABLE_SVC_FLAP_DETECTION" Nagios command.
@@ -1636,11 +1434,9 @@ func EnableSvcFlapDetection(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SVC_FLAP_DETECTION",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("ENABLE_SVC_FLAP_DETECTION").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // EnableSvcNotifications creates a new "ENABLE_SVC_NOTIFICATIONS" Nagios command.
@@ -1652,11 +1448,9 @@ func EnableSvcNotifications(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"ENABLE_SVC_NOTIFICATIONS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("ENABLE_SVC_NOTIFICATIONS").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // ProcessFile creates a new "PROCESS_FILE" Nagios command.
@@ -1670,11 +1464,9 @@ func ProcessFile(
 	file_name string,
 	delete bool,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"PROCESS_FILE",
-		stringifyArg("file_name", "string", file_name),
-		stringifyArg("delete", "bool", delete),
-	)
+	return livestatus.NewCommand("PROCESS_FILE").
+		Arg(file_name).
+		Arg(delete)
 }
 
 // ProcessHostCheckResult creates a new "PROCESS_HOST_CHECK_RESULT" Nagios command.
@@ -1687,15 +1479,13 @@ func ProcessFile(
 // The "plugin_output" argument contains the text returned from the host check, along with optional performance data.
 func ProcessHostCheckResult(
 	host_name string,
-	status_code int,
+	status_code HostState,
 	plugin_output string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"PROCESS_HOST_CHECK_RESULT",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("status_code", "int", status_code),
-		stringifyArg("plugin_output", "string", plugin_output),
-	)
+	return livestatus.NewCommand("PROCESS_HOST_CHECK_RESULT").
+		RequiredArg(host_name).
+		Arg(int(status_code)).
+		Arg(plugin_output)
 }
 
 // ProcessServiceCheckResult creates a new "PROCESS_SERVICE_CHECK_RESULT" Nagios command.
@@ -1708,16 +1498,14 @@ func ProcessHostCheckResult(
 func ProcessServiceCheckResult(
 	host_name string,
 	service_description string,
-	return_code int,
+	return_code ServiceState,
 	plugin_output string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"PROCESS_SERVICE_CHECK_RESULT",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("return_code", "int", return_code),
-		stringifyArg("plugin_output", "string", plugin_output),
-	)
+	return livestatus.NewCommand("PROCESS_SERVICE_CHECK_RESULT").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(int(return_code)).
+		Arg(plugin_output)
 }
 
 // ReadStateInformation creates a new "READ_STATE_INFORMATION" Nagios command.
@@ -1731,9 +1519,7 @@ func ProcessServiceCheckResult(
 //
 // Use with care.
 func ReadStateInformation() *livestatus.Command {
-	return livestatus.NewCommand(
-		"READ_STATE_INFORMATION",
-	)
+	return livestatus.NewCommand("READ_STATE_INFORMATION")
 }
 
 // RemoveHostAcknowledgement creates a new "REMOVE_HOST_ACKNOWLEDGEMENT" Nagios command.
@@ -1744,10 +1530,8 @@ func ReadStateInformation() *livestatus.Command {
 func RemoveHostAcknowledgement(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"REMOVE_HOST_ACKNOWLEDGEMENT",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("REMOVE_HOST_ACKNOWLEDGEMENT").
+		RequiredArg(host_name)
 }
 
 // RemoveSvcAcknowledgement creates a new "REMOVE_SVC_ACKNOWLEDGEMENT" Nagios command.
@@ -1759,20 +1543,16 @@ func RemoveSvcAcknowledgement(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"REMOVE_SVC_ACKNOWLEDGEMENT",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("REMOVE_SVC_ACKNOWLEDGEMENT").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // RestartProgram creates a new "RESTART_PROGRAM" Nagios command.
 //
 // Restarts the Nagios process.
 func RestartProgram() *livestatus.Command {
-	return livestatus.NewCommand(
-		"RESTART_PROGRAM",
-	)
+	return livestatus.NewCommand("RESTART_PROGRAM")
 }
 
 // SaveStateInformation creates a new "SAVE_STATE_INFORMATION" Nagios command.
@@ -1786,9 +1566,7 @@ func RestartProgram() *livestatus.Command {
 //
 // This does not affect the current status information in the Nagios process.
 func SaveStateInformation() *livestatus.Command {
-	return livestatus.NewCommand(
-		"SAVE_STATE_INFORMATION",
-	)
+	return livestatus.NewCommand("SAVE_STATE_INFORMATION")
 }
 
 // ScheduleAndPropagateHostDowntime creates a new "SCHEDULE_AND_PROPAGATE_HOST_DOWNTIME" Nagios command.
@@ -1817,17 +1595,15 @@ func ScheduleAndPropagateHostDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_AND_PROPAGATE_HOST_DOWNTIME",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_AND_PROPAGATE_HOST_DOWNTIME").
+		RequiredArg(host_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleAndPropagateTriggeredHostDowntime creates a new "SCHEDULE_AND_PROPAGATE_TRIGGERED_HOST_DOWNTIME" Nagios command.
@@ -1858,17 +1634,15 @@ func ScheduleAndPropagateTriggeredHostDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_AND_PROPAGATE_TRIGGERED_HOST_DOWNTIME",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_AND_PROPAGATE_TRIGGERED_HOST_DOWNTIME").
+		RequiredArg(host_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleForcedHostCheck creates a new "SCHEDULE_FORCED_HOST_CHECK" Nagios command.
@@ -1877,17 +1651,15 @@ func ScheduleAndPropagateTriggeredHostDowntime(
 //
 // The "check_time" argument is specified in time_t format (seconds since the UNIX epoch).
 //
-//  Forced checks are performed regardless of what time it is (e.g. timeperiod restrictions are ignored) and whether or
+// Forced checks are performed regardless of what time it is (e.g. timeperiod restrictions are ignored) and whether or
 // not active checks are enabled on a host-specific or program-wide basis.
 func ScheduleForcedHostCheck(
 	host_name string,
 	check_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_FORCED_HOST_CHECK",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("check_time", "time.Time", check_time),
-	)
+	return livestatus.NewCommand("SCHEDULE_FORCED_HOST_CHECK").
+		RequiredArg(host_name).
+		Arg(check_time)
 }
 
 // ScheduleForcedHostSvcChecks creates a new "SCHEDULE_FORCED_HOST_SVC_CHECKS" Nagios command.
@@ -1896,17 +1668,15 @@ func ScheduleForcedHostCheck(
 //
 // The "check_time" argument is specified in time_t format (seconds since the UNIX epoch).
 //
-//  Forced checks are performed regardless of what time it is (e.g. timeperiod restrictions are ignored) and whether or
+// Forced checks are performed regardless of what time it is (e.g. timeperiod restrictions are ignored) and whether or
 // not active checks are enabled on a service-specific or program-wide basis.
 func ScheduleForcedHostSvcChecks(
 	host_name string,
 	check_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_FORCED_HOST_SVC_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("check_time", "time.Time", check_time),
-	)
+	return livestatus.NewCommand("SCHEDULE_FORCED_HOST_SVC_CHECKS").
+		RequiredArg(host_name).
+		Arg(check_time)
 }
 
 // ScheduleForcedSvcCheck creates a new "SCHEDULE_FORCED_SVC_CHECK" Nagios command.
@@ -1915,19 +1685,17 @@ func ScheduleForcedHostSvcChecks(
 //
 // The "check_time" argument is specified in time_t format (seconds since the UNIX epoch).
 //
-//  Forced checks are performed regardless of what time it is (e.g. timeperiod restrictions are ignored) and whether or
+// Forced checks are performed regardless of what time it is (e.g. timeperiod restrictions are ignored) and whether or
 // not active checks are enabled on a service-specific or program-wide basis.
 func ScheduleForcedSvcCheck(
 	host_name string,
 	service_description string,
 	check_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_FORCED_SVC_CHECK",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_time", "time.Time", check_time),
-	)
+	return livestatus.NewCommand("SCHEDULE_FORCED_SVC_CHECK").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(check_time)
 }
 
 // ScheduleHostgroupHostDowntime creates a new "SCHEDULE_HOSTGROUP_HOST_DOWNTIME" Nagios command.
@@ -1956,17 +1724,15 @@ func ScheduleHostgroupHostDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_HOSTGROUP_HOST_DOWNTIME",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_HOSTGROUP_HOST_DOWNTIME").
+		RequiredArg(hostgroup_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleHostgroupSvcDowntime creates a new "SCHEDULE_HOSTGROUP_SVC_DOWNTIME" Nagios command.
@@ -1995,17 +1761,15 @@ func ScheduleHostgroupSvcDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_HOSTGROUP_SVC_DOWNTIME",
-		stringifyArg("hostgroup_name", "string", hostgroup_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_HOSTGROUP_SVC_DOWNTIME").
+		RequiredArg(hostgroup_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleHostCheck creates a new "SCHEDULE_HOST_CHECK" Nagios command.
@@ -2025,11 +1789,9 @@ func ScheduleHostCheck(
 	host_name string,
 	check_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_HOST_CHECK",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("check_time", "time.Time", check_time),
-	)
+	return livestatus.NewCommand("SCHEDULE_HOST_CHECK").
+		RequiredArg(host_name).
+		Arg(check_time)
 }
 
 // ScheduleHostDowntime creates a new "SCHEDULE_HOST_DOWNTIME" Nagios command.
@@ -2058,17 +1820,15 @@ func ScheduleHostDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_HOST_DOWNTIME",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_HOST_DOWNTIME").
+		RequiredArg(host_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleHostSvcChecks creates a new "SCHEDULE_HOST_SVC_CHECKS" Nagios command.
@@ -2088,11 +1848,9 @@ func ScheduleHostSvcChecks(
 	host_name string,
 	check_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_HOST_SVC_CHECKS",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("check_time", "time.Time", check_time),
-	)
+	return livestatus.NewCommand("SCHEDULE_HOST_SVC_CHECKS").
+		RequiredArg(host_name).
+		Arg(check_time)
 }
 
 // ScheduleHostSvcDowntime creates a new "SCHEDULE_HOST_SVC_DOWNTIME" Nagios command.
@@ -2121,17 +1879,15 @@ func ScheduleHostSvcDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_HOST_SVC_DOWNTIME",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_HOST_SVC_DOWNTIME").
+		RequiredArg(host_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleServicegroupHostDowntime creates a new "SCHEDULE_SERVICEGROUP_HOST_DOWNTIME" Nagios command.
@@ -2160,17 +1916,15 @@ func ScheduleServicegroupHostDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_SERVICEGROUP_HOST_DOWNTIME",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_SERVICEGROUP_HOST_DOWNTIME").
+		RequiredArg(servicegroup_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleServicegroupSvcDowntime creates a new "SCHEDULE_SERVICEGROUP_SVC_DOWNTIME" Nagios command.
@@ -2199,17 +1953,15 @@ func ScheduleServicegroupSvcDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_SERVICEGROUP_SVC_DOWNTIME",
-		stringifyArg("servicegroup_name", "string", servicegroup_name),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_SERVICEGROUP_SVC_DOWNTIME").
+		RequiredArg(servicegroup_name).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // ScheduleSvcCheck creates a new "SCHEDULE_SVC_CHECK" Nagios command.
@@ -2230,12 +1982,10 @@ func ScheduleSvcCheck(
 	service_description string,
 	check_time time.Time,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_SVC_CHECK",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("check_time", "time.Time", check_time),
-	)
+	return livestatus.NewCommand("SCHEDULE_SVC_CHECK").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(check_time)
 }
 
 // ScheduleSvcDowntime creates a new "SCHEDULE_SVC_DOWNTIME" Nagios command.
@@ -2265,18 +2015,16 @@ func ScheduleSvcDowntime(
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SCHEDULE_SVC_DOWNTIME",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("start_time", "time.Time", start_time),
-		stringifyArg("end_time", "time.Time", end_time),
-		stringifyArg("fixed", "bool", fixed),
-		stringifyArg("trigger_id", "int", trigger_id),
-		stringifyArg("duration", "time.Duration", duration),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SCHEDULE_SVC_DOWNTIME").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(start_time).
+		Arg(end_time).
+		Arg(fixed).
+		Arg(trigger_id).
+		Arg(duration).
+		Arg(author).
+		Arg(comment)
 }
 
 // SendCustomHostNotification creates a new "SEND_CUSTOM_HOST_NOTIFICATION" Nagios command.
@@ -2293,21 +2041,18 @@ func ScheduleSvcDowntime(
 // host), 2 = Forced (notification is sent out regardless of current time, whether or not notifications are enabled,
 // etc.), 4 = Increment current notification # for the host (this is not done by default for custom notifications).
 //
-// The comment field can be used with the
-// $NOTIFICATIONCOMMENT$ macro in notification commands.
+// The comment field can be used with the $NOTIFICATIONCOMMENT$ macro in notification commands.
 func SendCustomHostNotification(
 	host_name string,
-	options int,
+	options NotificationOptions,
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SEND_CUSTOM_HOST_NOTIFICATION",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("options", "int", options),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SEND_CUSTOM_HOST_NOTIFICATION").
+		RequiredArg(host_name).
+		Arg(int(options)).
+		Arg(author).
+		Arg(comment)
 }
 
 // SendCustomSvcNotification creates a new "SEND_CUSTOM_SVC_NOTIFICATION" Nagios command.
@@ -2324,23 +2069,20 @@ func SendCustomHostNotification(
 // service), 2 = Forced (notification is sent out regardless of current time, whether or not notifications are enabled,
 // etc.), 4 = Increment current notification # for the service(this is not done by default for custom notifications).
 //
-//  The comment field can be used with the
-// $NOTIFICATIONCOMMENT$ macro in notification commands.
+// The comment field can be used with the $NOTIFICATIONCOMMENT$ macro in notification commands.
 func SendCustomSvcNotification(
 	host_name string,
 	service_description string,
-	options int,
+	options NotificationOptions,
 	author string,
 	comment string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SEND_CUSTOM_SVC_NOTIFICATION",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("options", "int", options),
-		stringifyArg("author", "string", author),
-		stringifyArg("comment", "string", comment),
-	)
+	return livestatus.NewCommand("SEND_CUSTOM_SVC_NOTIFICATION").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(int(options)).
+		Arg(author).
+		Arg(comment)
 }
 
 // SetHostNotificationNumber creates a new "SET_HOST_NOTIFICATION_NUMBER" Nagios command.
@@ -2356,11 +2098,9 @@ func SetHostNotificationNumber(
 	host_name string,
 	notification_number int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SET_HOST_NOTIFICATION_NUMBER",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("notification_number", "int", notification_number),
-	)
+	return livestatus.NewCommand("SET_HOST_NOTIFICATION_NUMBER").
+		RequiredArg(host_name).
+		Arg(notification_number)
 }
 
 // SetSvcNotificationNumber creates a new "SET_SVC_NOTIFICATION_NUMBER" Nagios command.
@@ -2377,57 +2117,45 @@ func SetSvcNotificationNumber(
 	service_description string,
 	notification_number int,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"SET_SVC_NOTIFICATION_NUMBER",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-		stringifyArg("notification_number", "int", notification_number),
-	)
+	return livestatus.NewCommand("SET_SVC_NOTIFICATION_NUMBER").
+		RequiredArg(host_name).
+		RequiredArg(service_description).
+		Arg(notification_number)
 }
 
 // ShutdownProgram creates a new "SHUTDOWN_PROGRAM" Nagios command.
 //
 // Shuts down the Nagios process.
 func ShutdownProgram() *livestatus.Command {
-	return livestatus.NewCommand(
-		"SHUTDOWN_PROGRAM",
-	)
+	return livestatus.NewCommand("SHUTDOWN_PROGRAM")
 }
 
 // StartAcceptingPassiveHostChecks creates a new "START_ACCEPTING_PASSIVE_HOST_CHECKS" Nagios command.
 //
 // Enables acceptance and processing of passive host checks on a program-wide basis.
 func StartAcceptingPassiveHostChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_ACCEPTING_PASSIVE_HOST_CHECKS",
-	)
+	return livestatus.NewCommand("START_ACCEPTING_PASSIVE_HOST_CHECKS")
 }
 
 // StartAcceptingPassiveSvcChecks creates a new "START_ACCEPTING_PASSIVE_SVC_CHECKS" Nagios command.
 //
 // Enables passive service checks on a program-wide basis.
 func StartAcceptingPassiveSvcChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_ACCEPTING_PASSIVE_SVC_CHECKS",
-	)
+	return livestatus.NewCommand("START_ACCEPTING_PASSIVE_SVC_CHECKS")
 }
 
 // StartExecutingHostChecks creates a new "START_EXECUTING_HOST_CHECKS" Nagios command.
 //
 // Enables active host checks on a program-wide basis.
 func StartExecutingHostChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_EXECUTING_HOST_CHECKS",
-	)
+	return livestatus.NewCommand("START_EXECUTING_HOST_CHECKS")
 }
 
 // StartExecutingSvcChecks creates a new "START_EXECUTING_SVC_CHECKS" Nagios command.
 //
 // Enables active checks of services on a program-wide basis.
 func StartExecutingSvcChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_EXECUTING_SVC_CHECKS",
-	)
+	return livestatus.NewCommand("START_EXECUTING_SVC_CHECKS")
 }
 
 // StartObsessingOverHost creates a new "START_OBSESSING_OVER_HOST" Nagios command.
@@ -2436,19 +2164,15 @@ func StartExecutingSvcChecks() *livestatus.Command {
 func StartObsessingOverHost(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_OBSESSING_OVER_HOST",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("START_OBSESSING_OVER_HOST").
+		RequiredArg(host_name)
 }
 
 // StartObsessingOverHostChecks creates a new "START_OBSESSING_OVER_HOST_CHECKS" Nagios command.
 //
 // Enables processing of host checks via the OCHP command on a program-wide basis.
 func StartObsessingOverHostChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_OBSESSING_OVER_HOST_CHECKS",
-	)
+	return livestatus.NewCommand("START_OBSESSING_OVER_HOST_CHECKS")
 }
 
 // StartObsessingOverSvc creates a new "START_OBSESSING_OVER_SVC" Nagios command.
@@ -2458,56 +2182,44 @@ func StartObsessingOverSvc(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_OBSESSING_OVER_SVC",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("START_OBSESSING_OVER_SVC").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // StartObsessingOverSvcChecks creates a new "START_OBSESSING_OVER_SVC_CHECKS" Nagios command.
 //
 // Enables processing of service checks via the OCSP command on a program-wide basis.
 func StartObsessingOverSvcChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"START_OBSESSING_OVER_SVC_CHECKS",
-	)
+	return livestatus.NewCommand("START_OBSESSING_OVER_SVC_CHECKS")
 }
 
 // StopAcceptingPassiveHostChecks creates a new "STOP_ACCEPTING_PASSIVE_HOST_CHECKS" Nagios command.
 //
 // Disables acceptance and processing of passive host checks on a program-wide basis.
 func StopAcceptingPassiveHostChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_ACCEPTING_PASSIVE_HOST_CHECKS",
-	)
+	return livestatus.NewCommand("STOP_ACCEPTING_PASSIVE_HOST_CHECKS")
 }
 
 // StopAcceptingPassiveSvcChecks creates a new "STOP_ACCEPTING_PASSIVE_SVC_CHECKS" Nagios command.
 //
 // Disables passive service checks on a program-wide basis.
 func StopAcceptingPassiveSvcChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_ACCEPTING_PASSIVE_SVC_CHECKS",
-	)
+	return livestatus.NewCommand("STOP_ACCEPTING_PASSIVE_SVC_CHECKS")
 }
 
 // StopExecutingHostChecks creates a new "STOP_EXECUTING_HOST_CHECKS" Nagios command.
 //
 // Disables active host checks on a program-wide basis.
 func StopExecutingHostChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_EXECUTING_HOST_CHECKS",
-	)
+	return livestatus.NewCommand("STOP_EXECUTING_HOST_CHECKS")
 }
 
 // StopExecutingSvcChecks creates a new "STOP_EXECUTING_SVC_CHECKS" Nagios command.
 //
 // Disables active checks of services on a program-wide basis.
 func StopExecutingSvcChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_EXECUTING_SVC_CHECKS",
-	)
+	return livestatus.NewCommand("STOP_EXECUTING_SVC_CHECKS")
 }
 
 // StopObsessingOverHost creates a new "STOP_OBSESSING_OVER_HOST" Nagios command.
@@ -2516,19 +2228,15 @@ func StopExecutingSvcChecks() *livestatus.Command {
 func StopObsessingOverHost(
 	host_name string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_OBSESSING_OVER_HOST",
-		stringifyArg("host_name", "string", host_name),
-	)
+	return livestatus.NewCommand("STOP_OBSESSING_OVER_HOST").
+		RequiredArg(host_name)
 }
 
 // StopObsessingOverHostChecks creates a new "STOP_OBSESSING_OVER_HOST_CHECKS" Nagios command.
 //
 // Disables processing of host checks via the OCHP command on a program-wide basis.
 func StopObsessingOverHostChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_OBSESSING_OVER_HOST_CHECKS",
-	)
+	return livestatus.NewCommand("STOP_OBSESSING_OVER_HOST_CHECKS")
 }
 
 // StopObsessingOverSvc creates a new "STOP_OBSESSING_OVER_SVC" Nagios command.
@@ -2538,18 +2246,1031 @@ func StopObsessingOverSvc(
 	host_name string,
 	service_description string,
 ) *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_OBSESSING_OVER_SVC",
-		stringifyArg("host_name", "string", host_name),
-		stringifyArg("service_description", "string", service_description),
-	)
+	return livestatus.NewCommand("STOP_OBSESSING_OVER_SVC").
+		RequiredArg(host_name).
+		RequiredArg(service_description)
 }
 
 // StopObsessingOverSvcChecks creates a new "STOP_OBSESSING_OVER_SVC_CHECKS" Nagios command.
 //
 // Disables processing of service checks via the OCSP command on a program-wide basis.
 func StopObsessingOverSvcChecks() *livestatus.Command {
-	return livestatus.NewCommand(
-		"STOP_OBSESSING_OVER_SVC_CHECKS",
-	)
+	return livestatus.NewCommand("STOP_OBSESSING_OVER_SVC_CHECKS")
+}
+
+// Commands is the catalog of the Nagios commands, used to parse commands text representations.
+var Commands = Catalog{
+	"ACKNOWLEDGE_HOST_PROBLEM": {
+		Name: "ACKNOWLEDGE_HOST_PROBLEM",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "sticky", Type: ArgAckSticky},
+			{Name: "notify", Type: ArgBool},
+			{Name: "persistent", Type: ArgBool},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"ACKNOWLEDGE_SVC_PROBLEM": {
+		Name: "ACKNOWLEDGE_SVC_PROBLEM",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "sticky", Type: ArgAckSticky},
+			{Name: "notify", Type: ArgBool},
+			{Name: "persistent", Type: ArgBool},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"ADD_HOST_COMMENT": {
+		Name: "ADD_HOST_COMMENT",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "persistent", Type: ArgBool},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"ADD_SVC_COMMENT": {
+		Name: "ADD_SVC_COMMENT",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "persistent", Type: ArgBool},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"CHANGE_CONTACT_HOST_NOTIFICATION_TIMEPERIOD": {
+		Name: "CHANGE_CONTACT_HOST_NOTIFICATION_TIMEPERIOD",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+			{Name: "notification_timeperiod", Type: ArgString},
+		},
+	},
+	"CHANGE_CONTACT_MODATTR": {
+		Name: "CHANGE_CONTACT_MODATTR",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+			{Name: "value", Type: ArgString},
+		},
+	},
+	"CHANGE_CONTACT_MODHATTR": {
+		Name: "CHANGE_CONTACT_MODHATTR",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+			{Name: "value", Type: ArgString},
+		},
+	},
+	"CHANGE_CONTACT_MODSATTR": {
+		Name: "CHANGE_CONTACT_MODSATTR",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+			{Name: "value", Type: ArgString},
+		},
+	},
+	"CHANGE_CONTACT_SVC_NOTIFICATION_TIMEPERIOD": {
+		Name: "CHANGE_CONTACT_SVC_NOTIFICATION_TIMEPERIOD",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+			{Name: "notification_timeperiod", Type: ArgString},
+		},
+	},
+	"CHANGE_CUSTOM_CONTACT_VAR": {
+		Name: "CHANGE_CUSTOM_CONTACT_VAR",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+			{Name: "varname", Type: ArgString},
+			{Name: "varvalue", Type: ArgString},
+		},
+	},
+	"CHANGE_CUSTOM_HOST_VAR": {
+		Name: "CHANGE_CUSTOM_HOST_VAR",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "varname", Type: ArgString},
+			{Name: "varvalue", Type: ArgString},
+		},
+	},
+	"CHANGE_CUSTOM_SVC_VAR": {
+		Name: "CHANGE_CUSTOM_SVC_VAR",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "varname", Type: ArgString},
+			{Name: "varvalue", Type: ArgString},
+		},
+	},
+	"CHANGE_GLOBAL_HOST_EVENT_HANDLER": {
+		Name: "CHANGE_GLOBAL_HOST_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "event_handler_command", Type: ArgString},
+		},
+	},
+	"CHANGE_GLOBAL_SVC_EVENT_HANDLER": {
+		Name: "CHANGE_GLOBAL_SVC_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "event_handler_command", Type: ArgString},
+		},
+	},
+	"CHANGE_HOST_CHECK_COMMAND": {
+		Name: "CHANGE_HOST_CHECK_COMMAND",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "check_command", Type: ArgString},
+		},
+	},
+	"CHANGE_HOST_CHECK_TIMEPERIOD": {
+		Name: "CHANGE_HOST_CHECK_TIMEPERIOD",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "timeperiod", Type: ArgString},
+		},
+	},
+	"CHANGE_HOST_EVENT_HANDLER": {
+		Name: "CHANGE_HOST_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "event_handler_command", Type: ArgString},
+		},
+	},
+	"CHANGE_HOST_MODATTR": {
+		Name: "CHANGE_HOST_MODATTR",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "value", Type: ArgString},
+		},
+	},
+	"CHANGE_HOST_NOTIFICATION_TIMEPERIOD": {
+		Name: "CHANGE_HOST_NOTIFICATION_TIMEPERIOD",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "notification_timeperiod", Type: ArgString},
+		},
+	},
+	"CHANGE_MAX_HOST_CHECK_ATTEMPTS": {
+		Name: "CHANGE_MAX_HOST_CHECK_ATTEMPTS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "check_attempts", Type: ArgInt},
+		},
+	},
+	"CHANGE_MAX_SVC_CHECK_ATTEMPTS": {
+		Name: "CHANGE_MAX_SVC_CHECK_ATTEMPTS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_attempts", Type: ArgInt},
+		},
+	},
+	"CHANGE_NORMAL_HOST_CHECK_INTERVAL": {
+		Name: "CHANGE_NORMAL_HOST_CHECK_INTERVAL",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "check_interval", Type: ArgIntervals},
+		},
+	},
+	"CHANGE_NORMAL_SVC_CHECK_INTERVAL": {
+		Name: "CHANGE_NORMAL_SVC_CHECK_INTERVAL",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_interval", Type: ArgIntervals},
+		},
+	},
+	"CHANGE_RETRY_HOST_CHECK_INTERVAL": {
+		Name: "CHANGE_RETRY_HOST_CHECK_INTERVAL",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_interval", Type: ArgIntervals},
+		},
+	},
+	"CHANGE_RETRY_SVC_CHECK_INTERVAL": {
+		Name: "CHANGE_RETRY_SVC_CHECK_INTERVAL",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_interval", Type: ArgIntervals},
+		},
+	},
+	"CHANGE_SVC_CHECK_COMMAND": {
+		Name: "CHANGE_SVC_CHECK_COMMAND",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_command", Type: ArgString},
+		},
+	},
+	"CHANGE_SVC_CHECK_TIMEPERIOD": {
+		Name: "CHANGE_SVC_CHECK_TIMEPERIOD",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_timeperiod", Type: ArgString},
+		},
+	},
+	"CHANGE_SVC_EVENT_HANDLER": {
+		Name: "CHANGE_SVC_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "event_handler_command", Type: ArgString},
+		},
+	},
+	"CHANGE_SVC_MODATTR": {
+		Name: "CHANGE_SVC_MODATTR",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "value", Type: ArgString},
+		},
+	},
+	"CHANGE_SVC_NOTIFICATION_TIMEPERIOD": {
+		Name: "CHANGE_SVC_NOTIFICATION_TIMEPERIOD",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "notification_timeperiod", Type: ArgString},
+		},
+	},
+	"DELAY_HOST_NOTIFICATION": {
+		Name: "DELAY_HOST_NOTIFICATION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "notification_time", Type: ArgTime},
+		},
+	},
+	"DELAY_SVC_NOTIFICATION": {
+		Name: "DELAY_SVC_NOTIFICATION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "notification_time", Type: ArgTime},
+		},
+	},
+	"DEL_ALL_HOST_COMMENTS": {
+		Name: "DEL_ALL_HOST_COMMENTS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DEL_ALL_SVC_COMMENTS": {
+		Name: "DEL_ALL_SVC_COMMENTS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"DEL_HOST_COMMENT": {
+		Name: "DEL_HOST_COMMENT",
+		Args: []ArgSpec{
+			{Name: "comment_id", Type: ArgInt},
+		},
+	},
+	"DEL_HOST_DOWNTIME": {
+		Name: "DEL_HOST_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "downtime_id", Type: ArgInt},
+		},
+	},
+	"DEL_SVC_COMMENT": {
+		Name: "DEL_SVC_COMMENT",
+		Args: []ArgSpec{
+			{Name: "comment_id", Type: ArgInt},
+		},
+	},
+	"DEL_SVC_DOWNTIME": {
+		Name: "DEL_SVC_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "downtime_id", Type: ArgInt},
+		},
+	},
+	"DISABLE_ALL_NOTIFICATIONS_BEYOND_HOST": {
+		Name: "DISABLE_ALL_NOTIFICATIONS_BEYOND_HOST",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_CONTACTGROUP_HOST_NOTIFICATIONS": {
+		Name: "DISABLE_CONTACTGROUP_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contactgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_CONTACTGROUP_SVC_NOTIFICATIONS": {
+		Name: "DISABLE_CONTACTGROUP_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contactgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_CONTACT_HOST_NOTIFICATIONS": {
+		Name: "DISABLE_CONTACT_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_CONTACT_SVC_NOTIFICATIONS": {
+		Name: "DISABLE_CONTACT_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_EVENT_HANDLERS": {
+		Name: "DISABLE_EVENT_HANDLERS",
+	},
+	"DISABLE_FAILURE_PREDICTION": {
+		Name: "DISABLE_FAILURE_PREDICTION",
+	},
+	"DISABLE_FLAP_DETECTION": {
+		Name: "DISABLE_FLAP_DETECTION",
+	},
+	"DISABLE_HOSTGROUP_HOST_CHECKS": {
+		Name: "DISABLE_HOSTGROUP_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOSTGROUP_HOST_NOTIFICATIONS": {
+		Name: "DISABLE_HOSTGROUP_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOSTGROUP_PASSIVE_HOST_CHECKS": {
+		Name: "DISABLE_HOSTGROUP_PASSIVE_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOSTGROUP_PASSIVE_SVC_CHECKS": {
+		Name: "DISABLE_HOSTGROUP_PASSIVE_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOSTGROUP_SVC_CHECKS": {
+		Name: "DISABLE_HOSTGROUP_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOSTGROUP_SVC_NOTIFICATIONS": {
+		Name: "DISABLE_HOSTGROUP_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOST_AND_CHILD_NOTIFICATIONS": {
+		Name: "DISABLE_HOST_AND_CHILD_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOST_CHECK": {
+		Name: "DISABLE_HOST_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOST_EVENT_HANDLER": {
+		Name: "DISABLE_HOST_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOST_FLAP_DETECTION": {
+		Name: "DISABLE_HOST_FLAP_DETECTION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOST_FRESHNESS_CHECKS": {
+		Name: "DISABLE_HOST_FRESHNESS_CHECKS",
+	},
+	"DISABLE_HOST_NOTIFICATIONS": {
+		Name: "DISABLE_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOST_SVC_CHECKS": {
+		Name: "DISABLE_HOST_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_HOST_SVC_NOTIFICATIONS": {
+		Name: "DISABLE_HOST_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_NOTIFICATIONS": {
+		Name: "DISABLE_NOTIFICATIONS",
+	},
+	"DISABLE_PASSIVE_HOST_CHECKS": {
+		Name: "DISABLE_PASSIVE_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_PASSIVE_SVC_CHECKS": {
+		Name: "DISABLE_PASSIVE_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_PERFORMANCE_DATA": {
+		Name: "DISABLE_PERFORMANCE_DATA",
+	},
+	"DISABLE_SERVICEGROUP_HOST_CHECKS": {
+		Name: "DISABLE_SERVICEGROUP_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SERVICEGROUP_HOST_NOTIFICATIONS": {
+		Name: "DISABLE_SERVICEGROUP_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS": {
+		Name: "DISABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS": {
+		Name: "DISABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SERVICEGROUP_SVC_CHECKS": {
+		Name: "DISABLE_SERVICEGROUP_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SERVICEGROUP_SVC_NOTIFICATIONS": {
+		Name: "DISABLE_SERVICEGROUP_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SERVICE_FLAP_DETECTION": {
+		Name: "DISABLE_SERVICE_FLAP_DETECTION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SERVICE_FRESHNESS_CHECKS": {
+		Name: "DISABLE_SERVICE_FRESHNESS_CHECKS",
+	},
+	"DISABLE_SVC_CHECK": {
+		Name: "DISABLE_SVC_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SVC_EVENT_HANDLER": {
+		Name: "DISABLE_SVC_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SVC_FLAP_DETECTION": {
+		Name: "DISABLE_SVC_FLAP_DETECTION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"DISABLE_SVC_NOTIFICATIONS": {
+		Name: "DISABLE_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_ALL_NOTIFICATIONS_BEYOND_HOST": {
+		Name: "ENABLE_ALL_NOTIFICATIONS_BEYOND_HOST",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_CONTACTGROUP_HOST_NOTIFICATIONS": {
+		Name: "ENABLE_CONTACTGROUP_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contactgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_CONTACTGROUP_SVC_NOTIFICATIONS": {
+		Name: "ENABLE_CONTACTGROUP_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contactgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_CONTACT_HOST_NOTIFICATIONS": {
+		Name: "ENABLE_CONTACT_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_CONTACT_SVC_NOTIFICATIONS": {
+		Name: "ENABLE_CONTACT_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "contact_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_EVENT_HANDLERS": {
+		Name: "ENABLE_EVENT_HANDLERS",
+	},
+	"ENABLE_FAILURE_PREDICTION": {
+		Name: "ENABLE_FAILURE_PREDICTION",
+	},
+	"ENABLE_FLAP_DETECTION": {
+		Name: "ENABLE_FLAP_DETECTION",
+	},
+	"ENABLE_HOSTGROUP_HOST_CHECKS": {
+		Name: "ENABLE_HOSTGROUP_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOSTGROUP_HOST_NOTIFICATIONS": {
+		Name: "ENABLE_HOSTGROUP_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOSTGROUP_PASSIVE_HOST_CHECKS": {
+		Name: "ENABLE_HOSTGROUP_PASSIVE_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOSTGROUP_PASSIVE_SVC_CHECKS": {
+		Name: "ENABLE_HOSTGROUP_PASSIVE_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOSTGROUP_SVC_CHECKS": {
+		Name: "ENABLE_HOSTGROUP_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOSTGROUP_SVC_NOTIFICATIONS": {
+		Name: "ENABLE_HOSTGROUP_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOST_AND_CHILD_NOTIFICATIONS": {
+		Name: "ENABLE_HOST_AND_CHILD_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOST_CHECK": {
+		Name: "ENABLE_HOST_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOST_EVENT_HANDLER": {
+		Name: "ENABLE_HOST_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOST_FLAP_DETECTION": {
+		Name: "ENABLE_HOST_FLAP_DETECTION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOST_FRESHNESS_CHECKS": {
+		Name: "ENABLE_HOST_FRESHNESS_CHECKS",
+	},
+	"ENABLE_HOST_NOTIFICATIONS": {
+		Name: "ENABLE_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOST_SVC_CHECKS": {
+		Name: "ENABLE_HOST_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_HOST_SVC_NOTIFICATIONS": {
+		Name: "ENABLE_HOST_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_NOTIFICATIONS": {
+		Name: "ENABLE_NOTIFICATIONS",
+	},
+	"ENABLE_PASSIVE_HOST_CHECKS": {
+		Name: "ENABLE_PASSIVE_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_PASSIVE_SVC_CHECKS": {
+		Name: "ENABLE_PASSIVE_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_PERFORMANCE_DATA": {
+		Name: "ENABLE_PERFORMANCE_DATA",
+	},
+	"ENABLE_SERVICEGROUP_HOST_CHECKS": {
+		Name: "ENABLE_SERVICEGROUP_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SERVICEGROUP_HOST_NOTIFICATIONS": {
+		Name: "ENABLE_SERVICEGROUP_HOST_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS": {
+		Name: "ENABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS": {
+		Name: "ENABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SERVICEGROUP_SVC_CHECKS": {
+		Name: "ENABLE_SERVICEGROUP_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SERVICEGROUP_SVC_NOTIFICATIONS": {
+		Name: "ENABLE_SERVICEGROUP_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SERVICE_FRESHNESS_CHECKS": {
+		Name: "ENABLE_SERVICE_FRESHNESS_CHECKS",
+	},
+	"ENABLE_SVC_CHECK": {
+		Name: "ENABLE_SVC_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SVC_EVENT_HANDLER": {
+		Name: "ENABLE_SVC_EVENT_HANDLER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SVC_FLAP_DETECTION": {
+		Name: "ENABLE_SVC_FLAP_DETECTION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"ENABLE_SVC_NOTIFICATIONS": {
+		Name: "ENABLE_SVC_NOTIFICATIONS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"PROCESS_FILE": {
+		Name: "PROCESS_FILE",
+		Args: []ArgSpec{
+			{Name: "file_name", Type: ArgString},
+			{Name: "delete", Type: ArgBool},
+		},
+	},
+	"PROCESS_HOST_CHECK_RESULT": {
+		Name: "PROCESS_HOST_CHECK_RESULT",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "status_code", Type: ArgHostState},
+			{Name: "plugin_output", Type: ArgString},
+		},
+	},
+	"PROCESS_SERVICE_CHECK_RESULT": {
+		Name: "PROCESS_SERVICE_CHECK_RESULT",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "return_code", Type: ArgServiceState},
+			{Name: "plugin_output", Type: ArgString},
+		},
+	},
+	"READ_STATE_INFORMATION": {
+		Name: "READ_STATE_INFORMATION",
+	},
+	"REMOVE_HOST_ACKNOWLEDGEMENT": {
+		Name: "REMOVE_HOST_ACKNOWLEDGEMENT",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"REMOVE_SVC_ACKNOWLEDGEMENT": {
+		Name: "REMOVE_SVC_ACKNOWLEDGEMENT",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"RESTART_PROGRAM": {
+		Name: "RESTART_PROGRAM",
+	},
+	"SAVE_STATE_INFORMATION": {
+		Name: "SAVE_STATE_INFORMATION",
+	},
+	"SCHEDULE_AND_PROPAGATE_HOST_DOWNTIME": {
+		Name: "SCHEDULE_AND_PROPAGATE_HOST_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_AND_PROPAGATE_TRIGGERED_HOST_DOWNTIME": {
+		Name: "SCHEDULE_AND_PROPAGATE_TRIGGERED_HOST_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_FORCED_HOST_CHECK": {
+		Name: "SCHEDULE_FORCED_HOST_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "check_time", Type: ArgTime},
+		},
+	},
+	"SCHEDULE_FORCED_HOST_SVC_CHECKS": {
+		Name: "SCHEDULE_FORCED_HOST_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "check_time", Type: ArgTime},
+		},
+	},
+	"SCHEDULE_FORCED_SVC_CHECK": {
+		Name: "SCHEDULE_FORCED_SVC_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_time", Type: ArgTime},
+		},
+	},
+	"SCHEDULE_HOSTGROUP_HOST_DOWNTIME": {
+		Name: "SCHEDULE_HOSTGROUP_HOST_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_HOSTGROUP_SVC_DOWNTIME": {
+		Name: "SCHEDULE_HOSTGROUP_SVC_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "hostgroup_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_HOST_CHECK": {
+		Name: "SCHEDULE_HOST_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "check_time", Type: ArgTime},
+		},
+	},
+	"SCHEDULE_HOST_DOWNTIME": {
+		Name: "SCHEDULE_HOST_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_HOST_SVC_CHECKS": {
+		Name: "SCHEDULE_HOST_SVC_CHECKS",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "check_time", Type: ArgTime},
+		},
+	},
+	"SCHEDULE_HOST_SVC_DOWNTIME": {
+		Name: "SCHEDULE_HOST_SVC_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_SERVICEGROUP_HOST_DOWNTIME": {
+		Name: "SCHEDULE_SERVICEGROUP_HOST_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_SERVICEGROUP_SVC_DOWNTIME": {
+		Name: "SCHEDULE_SERVICEGROUP_SVC_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "servicegroup_name", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SCHEDULE_SVC_CHECK": {
+		Name: "SCHEDULE_SVC_CHECK",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "check_time", Type: ArgTime},
+		},
+	},
+	"SCHEDULE_SVC_DOWNTIME": {
+		Name: "SCHEDULE_SVC_DOWNTIME",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "start_time", Type: ArgTime},
+			{Name: "end_time", Type: ArgTime},
+			{Name: "fixed", Type: ArgBool},
+			{Name: "trigger_id", Type: ArgInt},
+			{Name: "duration", Type: ArgDuration},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SEND_CUSTOM_HOST_NOTIFICATION": {
+		Name: "SEND_CUSTOM_HOST_NOTIFICATION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "options", Type: ArgNotificationOptions},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SEND_CUSTOM_SVC_NOTIFICATION": {
+		Name: "SEND_CUSTOM_SVC_NOTIFICATION",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "options", Type: ArgNotificationOptions},
+			{Name: "author", Type: ArgString},
+			{Name: "comment", Type: ArgString},
+		},
+	},
+	"SET_HOST_NOTIFICATION_NUMBER": {
+		Name: "SET_HOST_NOTIFICATION_NUMBER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "notification_number", Type: ArgInt},
+		},
+	},
+	"SET_SVC_NOTIFICATION_NUMBER": {
+		Name: "SET_SVC_NOTIFICATION_NUMBER",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+			{Name: "notification_number", Type: ArgInt},
+		},
+	},
+	"SHUTDOWN_PROGRAM": {
+		Name: "SHUTDOWN_PROGRAM",
+	},
+	"START_ACCEPTING_PASSIVE_HOST_CHECKS": {
+		Name: "START_ACCEPTING_PASSIVE_HOST_CHECKS",
+	},
+	"START_ACCEPTING_PASSIVE_SVC_CHECKS": {
+		Name: "START_ACCEPTING_PASSIVE_SVC_CHECKS",
+	},
+	"START_EXECUTING_HOST_CHECKS": {
+		Name: "START_EXECUTING_HOST_CHECKS",
+	},
+	"START_EXECUTING_SVC_CHECKS": {
+		Name: "START_EXECUTING_SVC_CHECKS",
+	},
+	"START_OBSESSING_OVER_HOST": {
+		Name: "START_OBSESSING_OVER_HOST",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"START_OBSESSING_OVER_HOST_CHECKS": {
+		Name: "START_OBSESSING_OVER_HOST_CHECKS",
+	},
+	"START_OBSESSING_OVER_SVC": {
+		Name: "START_OBSESSING_OVER_SVC",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"START_OBSESSING_OVER_SVC_CHECKS": {
+		Name: "START_OBSESSING_OVER_SVC_CHECKS",
+	},
+	"STOP_ACCEPTING_PASSIVE_HOST_CHECKS": {
+		Name: "STOP_ACCEPTING_PASSIVE_HOST_CHECKS",
+	},
+	"STOP_ACCEPTING_PASSIVE_SVC_CHECKS": {
+		Name: "STOP_ACCEPTING_PASSIVE_SVC_CHECKS",
+	},
+	"STOP_EXECUTING_HOST_CHECKS": {
+		Name: "STOP_EXECUTING_HOST_CHECKS",
+	},
+	"STOP_EXECUTING_SVC_CHECKS": {
+		Name: "STOP_EXECUTING_SVC_CHECKS",
+	},
+	"STOP_OBSESSING_OVER_HOST": {
+		Name: "STOP_OBSESSING_OVER_HOST",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+		},
+	},
+	"STOP_OBSESSING_OVER_HOST_CHECKS": {
+		Name: "STOP_OBSESSING_OVER_HOST_CHECKS",
+	},
+	"STOP_OBSESSING_OVER_SVC": {
+		Name: "STOP_OBSESSING_OVER_SVC",
+		Args: []ArgSpec{
+			{Name: "host_name", Type: ArgString, Required: true},
+			{Name: "service_description", Type: ArgString, Required: true},
+		},
+	},
+	"STOP_OBSESSING_OVER_SVC_CHECKS": {
+		Name: "STOP_OBSESSING_OVER_SVC_CHECKS",
+	},
 }
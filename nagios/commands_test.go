@@ -0,0 +1,662 @@
+// Generated by go generate; DO NOT EDIT.
+
+package nagios
+
+import (
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+func Test_Commands(t *testing.T) {
+	for _, test := range []struct {
+		cmd      *livestatus.Command
+		expected string
+	}{
+		{
+			AcknowledgeHostProblem("host_name", AckStickyUntilOK, true, true, "author", "comment"),
+			"COMMAND [1439633040] ACKNOWLEDGE_HOST_PROBLEM;host_name;2;1;1;author;comment\n\n",
+		},
+		{
+			AcknowledgeSvcProblem("host_name", "service_description", AckStickyUntilOK, true, true, "author", "comment"),
+			"COMMAND [1439633040] ACKNOWLEDGE_SVC_PROBLEM;host_name;service_description;2;1;1;author;comment\n\n",
+		},
+		{
+			AddHostComment("host_name", true, "author", "comment"),
+			"COMMAND [1439633040] ADD_HOST_COMMENT;host_name;1;author;comment\n\n",
+		},
+		{
+			AddSvcComment("host_name", "service_description", true, "author", "comment"),
+			"COMMAND [1439633040] ADD_SVC_COMMENT;host_name;service_description;1;author;comment\n\n",
+		},
+		{
+			ChangeContactHostNotificationTimeperiod("contact_name", "notification_timeperiod"),
+			"COMMAND [1439633040] CHANGE_CONTACT_HOST_NOTIFICATION_TIMEPERIOD;contact_name;notification_timeperiod\n\n",
+		},
+		{
+			ChangeContactModattr("contact_name", "value"),
+			"COMMAND [1439633040] CHANGE_CONTACT_MODATTR;contact_name;value\n\n",
+		},
+		{
+			ChangeContactModhattr("contact_name", "value"),
+			"COMMAND [1439633040] CHANGE_CONTACT_MODHATTR;contact_name;value\n\n",
+		},
+		{
+			ChangeContactModsattr("contact_name", "value"),
+			"COMMAND [1439633040] CHANGE_CONTACT_MODSATTR;contact_name;value\n\n",
+		},
+		{
+			ChangeContactSvcNotificationTimeperiod("contact_name", "notification_timeperiod"),
+			"COMMAND [1439633040] CHANGE_CONTACT_SVC_NOTIFICATION_TIMEPERIOD;contact_name;notification_timeperiod\n\n",
+		},
+		{
+			ChangeCustomContactVar("contact_name", "varname", "varvalue"),
+			"COMMAND [1439633040] CHANGE_CUSTOM_CONTACT_VAR;contact_name;varname;varvalue\n\n",
+		},
+		{
+			ChangeCustomHostVar("host_name", "varname", "varvalue"),
+			"COMMAND [1439633040] CHANGE_CUSTOM_HOST_VAR;host_name;varname;varvalue\n\n",
+		},
+		{
+			ChangeCustomSvcVar("host_name", "service_description", "varname", "varvalue"),
+			"COMMAND [1439633040] CHANGE_CUSTOM_SVC_VAR;host_name;service_description;varname;varvalue\n\n",
+		},
+		{
+			ChangeGlobalHostEventHandler("event_handler_command"),
+			"COMMAND [1439633040] CHANGE_GLOBAL_HOST_EVENT_HANDLER;event_handler_command\n\n",
+		},
+		{
+			ChangeGlobalSvcEventHandler("event_handler_command"),
+			"COMMAND [1439633040] CHANGE_GLOBAL_SVC_EVENT_HANDLER;event_handler_command\n\n",
+		},
+		{
+			ChangeHostCheckCommand("host_name", "check_command"),
+			"COMMAND [1439633040] CHANGE_HOST_CHECK_COMMAND;host_name;check_command\n\n",
+		},
+		{
+			ChangeHostCheckTimeperiod("host_name", "timeperiod"),
+			"COMMAND [1439633040] CHANGE_HOST_CHECK_TIMEPERIOD;host_name;timeperiod\n\n",
+		},
+		{
+			ChangeHostEventHandler("host_name", "event_handler_command"),
+			"COMMAND [1439633040] CHANGE_HOST_EVENT_HANDLER;host_name;event_handler_command\n\n",
+		},
+		{
+			ChangeHostModattr("host_name", "value"),
+			"COMMAND [1439633040] CHANGE_HOST_MODATTR;host_name;value\n\n",
+		},
+		{
+			ChangeHostNotificationTimeperiod("host_name", "notification_timeperiod"),
+			"COMMAND [1439633040] CHANGE_HOST_NOTIFICATION_TIMEPERIOD;host_name;notification_timeperiod\n\n",
+		},
+		{
+			ChangeMaxHostCheckAttempts("host_name", 1),
+			"COMMAND [1439633040] CHANGE_MAX_HOST_CHECK_ATTEMPTS;host_name;1\n\n",
+		},
+		{
+			ChangeMaxSvcCheckAttempts("host_name", "service_description", 1),
+			"COMMAND [1439633040] CHANGE_MAX_SVC_CHECK_ATTEMPTS;host_name;service_description;1\n\n",
+		},
+		{
+			ChangeNormalHostCheckInterval("host_name", ToIntervals(5*time.Minute, 0)),
+			"COMMAND [1439633040] CHANGE_NORMAL_HOST_CHECK_INTERVAL;host_name;5\n\n",
+		},
+		{
+			ChangeNormalSvcCheckInterval("host_name", "service_description", ToIntervals(5*time.Minute, 0)),
+			"COMMAND [1439633040] CHANGE_NORMAL_SVC_CHECK_INTERVAL;host_name;service_description;5\n\n",
+		},
+		{
+			ChangeRetryHostCheckInterval("host_name", "service_description", ToIntervals(5*time.Minute, 0)),
+			"COMMAND [1439633040] CHANGE_RETRY_HOST_CHECK_INTERVAL;host_name;service_description;5\n\n",
+		},
+		{
+			ChangeRetrySvcCheckInterval("host_name", "service_description", ToIntervals(5*time.Minute, 0)),
+			"COMMAND [1439633040] CHANGE_RETRY_SVC_CHECK_INTERVAL;host_name;service_description;5\n\n",
+		},
+		{
+			ChangeSvcCheckCommand("host_name", "service_description", "check_command"),
+			"COMMAND [1439633040] CHANGE_SVC_CHECK_COMMAND;host_name;service_description;check_command\n\n",
+		},
+		{
+			ChangeSvcCheckTimeperiod("host_name", "service_description", "check_timeperiod"),
+			"COMMAND [1439633040] CHANGE_SVC_CHECK_TIMEPERIOD;host_name;service_description;check_timeperiod\n\n",
+		},
+		{
+			ChangeSvcEventHandler("host_name", "service_description", "event_handler_command"),
+			"COMMAND [1439633040] CHANGE_SVC_EVENT_HANDLER;host_name;service_description;event_handler_command\n\n",
+		},
+		{
+			ChangeSvcModattr("host_name", "service_description", "value"),
+			"COMMAND [1439633040] CHANGE_SVC_MODATTR;host_name;service_description;value\n\n",
+		},
+		{
+			ChangeSvcNotificationTimeperiod("host_name", "service_description", "notification_timeperiod"),
+			"COMMAND [1439633040] CHANGE_SVC_NOTIFICATION_TIMEPERIOD;host_name;service_description;notification_timeperiod\n\n",
+		},
+		{
+			DelayHostNotification("host_name", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] DELAY_HOST_NOTIFICATION;host_name;1439633040\n\n",
+		},
+		{
+			DelaySvcNotification("host_name", "service_description", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] DELAY_SVC_NOTIFICATION;host_name;service_description;1439633040\n\n",
+		},
+		{
+			DelAllHostComments("host_name"),
+			"COMMAND [1439633040] DEL_ALL_HOST_COMMENTS;host_name\n\n",
+		},
+		{
+			DelAllSvcComments("host_name", "service_description"),
+			"COMMAND [1439633040] DEL_ALL_SVC_COMMENTS;host_name;service_description\n\n",
+		},
+		{
+			DelHostComment(1),
+			"COMMAND [1439633040] DEL_HOST_COMMENT;1\n\n",
+		},
+		{
+			DelHostDowntime(1),
+			"COMMAND [1439633040] DEL_HOST_DOWNTIME;1\n\n",
+		},
+		{
+			DelSvcComment(1),
+			"COMMAND [1439633040] DEL_SVC_COMMENT;1\n\n",
+		},
+		{
+			DelSvcDowntime(1),
+			"COMMAND [1439633040] DEL_SVC_DOWNTIME;1\n\n",
+		},
+		{
+			DisableAllNotificationsBeyondHost("host_name"),
+			"COMMAND [1439633040] DISABLE_ALL_NOTIFICATIONS_BEYOND_HOST;host_name\n\n",
+		},
+		{
+			DisableContactgroupHostNotifications("contactgroup_name"),
+			"COMMAND [1439633040] DISABLE_CONTACTGROUP_HOST_NOTIFICATIONS;contactgroup_name\n\n",
+		},
+		{
+			DisableContactgroupSvcNotifications("contactgroup_name"),
+			"COMMAND [1439633040] DISABLE_CONTACTGROUP_SVC_NOTIFICATIONS;contactgroup_name\n\n",
+		},
+		{
+			DisableContactHostNotifications("contact_name"),
+			"COMMAND [1439633040] DISABLE_CONTACT_HOST_NOTIFICATIONS;contact_name\n\n",
+		},
+		{
+			DisableContactSvcNotifications("contact_name"),
+			"COMMAND [1439633040] DISABLE_CONTACT_SVC_NOTIFICATIONS;contact_name\n\n",
+		},
+		{
+			DisableEventHandlers(),
+			"COMMAND [1439633040] DISABLE_EVENT_HANDLERS\n\n",
+		},
+		{
+			DisableFailurePrediction(),
+			"COMMAND [1439633040] DISABLE_FAILURE_PREDICTION\n\n",
+		},
+		{
+			DisableFlapDetection(),
+			"COMMAND [1439633040] DISABLE_FLAP_DETECTION\n\n",
+		},
+		{
+			DisableHostgroupHostChecks("hostgroup_name"),
+			"COMMAND [1439633040] DISABLE_HOSTGROUP_HOST_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			DisableHostgroupHostNotifications("hostgroup_name"),
+			"COMMAND [1439633040] DISABLE_HOSTGROUP_HOST_NOTIFICATIONS;hostgroup_name\n\n",
+		},
+		{
+			DisableHostgroupPassiveHostChecks("hostgroup_name"),
+			"COMMAND [1439633040] DISABLE_HOSTGROUP_PASSIVE_HOST_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			DisableHostgroupPassiveSvcChecks("hostgroup_name"),
+			"COMMAND [1439633040] DISABLE_HOSTGROUP_PASSIVE_SVC_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			DisableHostgroupSvcChecks("hostgroup_name"),
+			"COMMAND [1439633040] DISABLE_HOSTGROUP_SVC_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			DisableHostgroupSvcNotifications("hostgroup_name"),
+			"COMMAND [1439633040] DISABLE_HOSTGROUP_SVC_NOTIFICATIONS;hostgroup_name\n\n",
+		},
+		{
+			DisableHostAndChildNotifications("host_name"),
+			"COMMAND [1439633040] DISABLE_HOST_AND_CHILD_NOTIFICATIONS;host_name\n\n",
+		},
+		{
+			DisableHostCheck("host_name"),
+			"COMMAND [1439633040] DISABLE_HOST_CHECK;host_name\n\n",
+		},
+		{
+			DisableHostEventHandler("host_name"),
+			"COMMAND [1439633040] DISABLE_HOST_EVENT_HANDLER;host_name\n\n",
+		},
+		{
+			DisableHostFlapDetection("host_name"),
+			"COMMAND [1439633040] DISABLE_HOST_FLAP_DETECTION;host_name\n\n",
+		},
+		{
+			DisableHostFreshnessChecks(),
+			"COMMAND [1439633040] DISABLE_HOST_FRESHNESS_CHECKS\n\n",
+		},
+		{
+			DisableHostNotifications("host_name"),
+			"COMMAND [1439633040] DISABLE_HOST_NOTIFICATIONS;host_name\n\n",
+		},
+		{
+			DisableHostSvcChecks("host_name"),
+			"COMMAND [1439633040] DISABLE_HOST_SVC_CHECKS;host_name\n\n",
+		},
+		{
+			DisableHostSvcNotifications("host_name"),
+			"COMMAND [1439633040] DISABLE_HOST_SVC_NOTIFICATIONS;host_name\n\n",
+		},
+		{
+			DisableNotifications(),
+			"COMMAND [1439633040] DISABLE_NOTIFICATIONS\n\n",
+		},
+		{
+			DisablePassiveHostChecks("host_name"),
+			"COMMAND [1439633040] DISABLE_PASSIVE_HOST_CHECKS;host_name\n\n",
+		},
+		{
+			DisablePassiveSvcChecks("host_name", "service_description"),
+			"COMMAND [1439633040] DISABLE_PASSIVE_SVC_CHECKS;host_name;service_description\n\n",
+		},
+		{
+			DisablePerformanceData(),
+			"COMMAND [1439633040] DISABLE_PERFORMANCE_DATA\n\n",
+		},
+		{
+			DisableServicegroupHostChecks("servicegroup_name"),
+			"COMMAND [1439633040] DISABLE_SERVICEGROUP_HOST_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			DisableServicegroupHostNotifications("servicegroup_name"),
+			"COMMAND [1439633040] DISABLE_SERVICEGROUP_HOST_NOTIFICATIONS;servicegroup_name\n\n",
+		},
+		{
+			DisableServicegroupPassiveHostChecks("servicegroup_name"),
+			"COMMAND [1439633040] DISABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			DisableServicegroupPassiveSvcChecks("servicegroup_name"),
+			"COMMAND [1439633040] DISABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			DisableServicegroupSvcChecks("servicegroup_name"),
+			"COMMAND [1439633040] DISABLE_SERVICEGROUP_SVC_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			DisableServicegroupSvcNotifications("servicegroup_name"),
+			"COMMAND [1439633040] DISABLE_SERVICEGROUP_SVC_NOTIFICATIONS;servicegroup_name\n\n",
+		},
+		{
+			DisableServiceFlapDetection("host_name", "service_description"),
+			"COMMAND [1439633040] DISABLE_SERVICE_FLAP_DETECTION;host_name;service_description\n\n",
+		},
+		{
+			DisableServiceFreshnessChecks(),
+			"COMMAND [1439633040] DISABLE_SERVICE_FRESHNESS_CHECKS\n\n",
+		},
+		{
+			DisableSvcCheck("host_name", "service_description"),
+			"COMMAND [1439633040] DISABLE_SVC_CHECK;host_name;service_description\n\n",
+		},
+		{
+			DisableSvcEventHandler("host_name", "service_description"),
+			"COMMAND [1439633040] DISABLE_SVC_EVENT_HANDLER;host_name;service_description\n\n",
+		},
+		{
+			DisableSvcFlapDetection("host_name", "service_description"),
+			"COMMAND [1439633040] DISABLE_SVC_FLAP_DETECTION;host_name;service_description\n\n",
+		},
+		{
+			DisableSvcNotifications("host_name", "service_description"),
+			"COMMAND [1439633040] DISABLE_SVC_NOTIFICATIONS;host_name;service_description\n\n",
+		},
+		{
+			EnableAllNotificationsBeyondHost("host_name"),
+			"COMMAND [1439633040] ENABLE_ALL_NOTIFICATIONS_BEYOND_HOST;host_name\n\n",
+		},
+		{
+			EnableContactgroupHostNotifications("contactgroup_name"),
+			"COMMAND [1439633040] ENABLE_CONTACTGROUP_HOST_NOTIFICATIONS;contactgroup_name\n\n",
+		},
+		{
+			EnableContactgroupSvcNotifications("contactgroup_name"),
+			"COMMAND [1439633040] ENABLE_CONTACTGROUP_SVC_NOTIFICATIONS;contactgroup_name\n\n",
+		},
+		{
+			EnableContactHostNotifications("contact_name"),
+			"COMMAND [1439633040] ENABLE_CONTACT_HOST_NOTIFICATIONS;contact_name\n\n",
+		},
+		{
+			EnableContactSvcNotifications("contact_name"),
+			"COMMAND [1439633040] ENABLE_CONTACT_SVC_NOTIFICATIONS;contact_name\n\n",
+		},
+		{
+			EnableEventHandlers(),
+			"COMMAND [1439633040] ENABLE_EVENT_HANDLERS\n\n",
+		},
+		{
+			EnableFailurePrediction(),
+			"COMMAND [1439633040] ENABLE_FAILURE_PREDICTION\n\n",
+		},
+		{
+			EnableFlapDetection(),
+			"COMMAND [1439633040] ENABLE_FLAP_DETECTION\n\n",
+		},
+		{
+			EnableHostgroupHostChecks("hostgroup_name"),
+			"COMMAND [1439633040] ENABLE_HOSTGROUP_HOST_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			EnableHostgroupHostNotifications("hostgroup_name"),
+			"COMMAND [1439633040] ENABLE_HOSTGROUP_HOST_NOTIFICATIONS;hostgroup_name\n\n",
+		},
+		{
+			EnableHostgroupPassiveHostChecks("hostgroup_name"),
+			"COMMAND [1439633040] ENABLE_HOSTGROUP_PASSIVE_HOST_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			EnableHostgroupPassiveSvcChecks("hostgroup_name"),
+			"COMMAND [1439633040] ENABLE_HOSTGROUP_PASSIVE_SVC_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			EnableHostgroupSvcChecks("hostgroup_name"),
+			"COMMAND [1439633040] ENABLE_HOSTGROUP_SVC_CHECKS;hostgroup_name\n\n",
+		},
+		{
+			EnableHostgroupSvcNotifications("hostgroup_name"),
+			"COMMAND [1439633040] ENABLE_HOSTGROUP_SVC_NOTIFICATIONS;hostgroup_name\n\n",
+		},
+		{
+			EnableHostAndChildNotifications("host_name"),
+			"COMMAND [1439633040] ENABLE_HOST_AND_CHILD_NOTIFICATIONS;host_name\n\n",
+		},
+		{
+			EnableHostCheck("host_name"),
+			"COMMAND [1439633040] ENABLE_HOST_CHECK;host_name\n\n",
+		},
+		{
+			EnableHostEventHandler("host_name"),
+			"COMMAND [1439633040] ENABLE_HOST_EVENT_HANDLER;host_name\n\n",
+		},
+		{
+			EnableHostFlapDetection("host_name"),
+			"COMMAND [1439633040] ENABLE_HOST_FLAP_DETECTION;host_name\n\n",
+		},
+		{
+			EnableHostFreshnessChecks(),
+			"COMMAND [1439633040] ENABLE_HOST_FRESHNESS_CHECKS\n\n",
+		},
+		{
+			EnableHostNotifications("host_name"),
+			"COMMAND [1439633040] ENABLE_HOST_NOTIFICATIONS;host_name\n\n",
+		},
+		{
+			EnableHostSvcChecks("host_name"),
+			"COMMAND [1439633040] ENABLE_HOST_SVC_CHECKS;host_name\n\n",
+		},
+		{
+			EnableHostSvcNotifications("host_name"),
+			"COMMAND [1439633040] ENABLE_HOST_SVC_NOTIFICATIONS;host_name\n\n",
+		},
+		{
+			EnableNotifications(),
+			"COMMAND [1439633040] ENABLE_NOTIFICATIONS\n\n",
+		},
+		{
+			EnablePassiveHostChecks("host_name"),
+			"COMMAND [1439633040] ENABLE_PASSIVE_HOST_CHECKS;host_name\n\n",
+		},
+		{
+			EnablePassiveSvcChecks("host_name", "service_description"),
+			"COMMAND [1439633040] ENABLE_PASSIVE_SVC_CHECKS;host_name;service_description\n\n",
+		},
+		{
+			EnablePerformanceData(),
+			"COMMAND [1439633040] ENABLE_PERFORMANCE_DATA\n\n",
+		},
+		{
+			EnableServicegroupHostChecks("servicegroup_name"),
+			"COMMAND [1439633040] ENABLE_SERVICEGROUP_HOST_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			EnableServicegroupHostNotifications("servicegroup_name"),
+			"COMMAND [1439633040] ENABLE_SERVICEGROUP_HOST_NOTIFICATIONS;servicegroup_name\n\n",
+		},
+		{
+			EnableServicegroupPassiveHostChecks("servicegroup_name"),
+			"COMMAND [1439633040] ENABLE_SERVICEGROUP_PASSIVE_HOST_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			EnableServicegroupPassiveSvcChecks("servicegroup_name"),
+			"COMMAND [1439633040] ENABLE_SERVICEGROUP_PASSIVE_SVC_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			EnableServicegroupSvcChecks("servicegroup_name"),
+			"COMMAND [1439633040] ENABLE_SERVICEGROUP_SVC_CHECKS;servicegroup_name\n\n",
+		},
+		{
+			EnableServicegroupSvcNotifications("servicegroup_name"),
+			"COMMAND [1439633040] ENABLE_SERVICEGROUP_SVC_NOTIFICATIONS;servicegroup_name\n\n",
+		},
+		{
+			EnableServiceFreshnessChecks(),
+			"COMMAND [1439633040] ENABLE_SERVICE_FRESHNESS_CHECKS\n\n",
+		},
+		{
+			EnableSvcCheck("host_name", "service_description"),
+			"COMMAND [1439633040] ENABLE_SVC_CHECK;host_name;service_description\n\n",
+		},
+		{
+			EnableSvcEventHandler("host_name", "service_description"),
+			"COMMAND [1439633040] ENABLE_SVC_EVENT_HANDLER;host_name;service_description\n\n",
+		},
+		{
+			EnableSvcFlapDetection("host_name", "service_description"),
+			"COMMAND [1439633040] ENABLE_SVC_FLAP_DETECTION;host_name;service_description\n\n",
+		},
+		{
+			EnableSvcNotifications("host_name", "service_description"),
+			"COMMAND [1439633040] ENABLE_SVC_NOTIFICATIONS;host_name;service_description\n\n",
+		},
+		{
+			ProcessFile("file_name", true),
+			"COMMAND [1439633040] PROCESS_FILE;file_name;1\n\n",
+		},
+		{
+			ProcessHostCheckResult("host_name", HostDown, "plugin_output"),
+			"COMMAND [1439633040] PROCESS_HOST_CHECK_RESULT;host_name;1;plugin_output\n\n",
+		},
+		{
+			ProcessServiceCheckResult("host_name", "service_description", ServiceCritical, "plugin_output"),
+			"COMMAND [1439633040] PROCESS_SERVICE_CHECK_RESULT;host_name;service_description;2;plugin_output\n\n",
+		},
+		{
+			ReadStateInformation(),
+			"COMMAND [1439633040] READ_STATE_INFORMATION\n\n",
+		},
+		{
+			RemoveHostAcknowledgement("host_name"),
+			"COMMAND [1439633040] REMOVE_HOST_ACKNOWLEDGEMENT;host_name\n\n",
+		},
+		{
+			RemoveSvcAcknowledgement("host_name", "service_description"),
+			"COMMAND [1439633040] REMOVE_SVC_ACKNOWLEDGEMENT;host_name;service_description\n\n",
+		},
+		{
+			RestartProgram(),
+			"COMMAND [1439633040] RESTART_PROGRAM\n\n",
+		},
+		{
+			SaveStateInformation(),
+			"COMMAND [1439633040] SAVE_STATE_INFORMATION\n\n",
+		},
+		{
+			ScheduleAndPropagateHostDowntime("host_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_AND_PROPAGATE_HOST_DOWNTIME;host_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleAndPropagateTriggeredHostDowntime("host_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_AND_PROPAGATE_TRIGGERED_HOST_DOWNTIME;host_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleForcedHostCheck("host_name", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] SCHEDULE_FORCED_HOST_CHECK;host_name;1439633040\n\n",
+		},
+		{
+			ScheduleForcedHostSvcChecks("host_name", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] SCHEDULE_FORCED_HOST_SVC_CHECKS;host_name;1439633040\n\n",
+		},
+		{
+			ScheduleForcedSvcCheck("host_name", "service_description", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] SCHEDULE_FORCED_SVC_CHECK;host_name;service_description;1439633040\n\n",
+		},
+		{
+			ScheduleHostgroupHostDowntime("hostgroup_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_HOSTGROUP_HOST_DOWNTIME;hostgroup_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleHostgroupSvcDowntime("hostgroup_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_HOSTGROUP_SVC_DOWNTIME;hostgroup_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleHostCheck("host_name", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] SCHEDULE_HOST_CHECK;host_name;1439633040\n\n",
+		},
+		{
+			ScheduleHostDowntime("host_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_HOST_DOWNTIME;host_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleHostSvcChecks("host_name", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] SCHEDULE_HOST_SVC_CHECKS;host_name;1439633040\n\n",
+		},
+		{
+			ScheduleHostSvcDowntime("host_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_HOST_SVC_DOWNTIME;host_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleServicegroupHostDowntime("servicegroup_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_SERVICEGROUP_HOST_DOWNTIME;servicegroup_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleServicegroupSvcDowntime("servicegroup_name", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_SERVICEGROUP_SVC_DOWNTIME;servicegroup_name;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			ScheduleSvcCheck("host_name", "service_description", time.Unix(1439633040, 0)),
+			"COMMAND [1439633040] SCHEDULE_SVC_CHECK;host_name;service_description;1439633040\n\n",
+		},
+		{
+			ScheduleSvcDowntime("host_name", "service_description", time.Unix(1439633040, 0), time.Unix(1439633040, 0), true, 1, 5*time.Minute, "author", "comment"),
+			"COMMAND [1439633040] SCHEDULE_SVC_DOWNTIME;host_name;service_description;1439633040;1439633040;1;1;300;author;comment\n\n",
+		},
+		{
+			SendCustomHostNotification("host_name", NotificationBroadcast|NotificationForced, "author", "comment"),
+			"COMMAND [1439633040] SEND_CUSTOM_HOST_NOTIFICATION;host_name;3;author;comment\n\n",
+		},
+		{
+			SendCustomSvcNotification("host_name", "service_description", NotificationBroadcast|NotificationForced, "author", "comment"),
+			"COMMAND [1439633040] SEND_CUSTOM_SVC_NOTIFICATION;host_name;service_description;3;author;comment\n\n",
+		},
+		{
+			SetHostNotificationNumber("host_name", 1),
+			"COMMAND [1439633040] SET_HOST_NOTIFICATION_NUMBER;host_name;1\n\n",
+		},
+		{
+			SetSvcNotificationNumber("host_name", "service_description", 1),
+			"COMMAND [1439633040] SET_SVC_NOTIFICATION_NUMBER;host_name;service_description;1\n\n",
+		},
+		{
+			ShutdownProgram(),
+			"COMMAND [1439633040] SHUTDOWN_PROGRAM\n\n",
+		},
+		{
+			StartAcceptingPassiveHostChecks(),
+			"COMMAND [1439633040] START_ACCEPTING_PASSIVE_HOST_CHECKS\n\n",
+		},
+		{
+			StartAcceptingPassiveSvcChecks(),
+			"COMMAND [1439633040] START_ACCEPTING_PASSIVE_SVC_CHECKS\n\n",
+		},
+		{
+			StartExecutingHostChecks(),
+			"COMMAND [1439633040] START_EXECUTING_HOST_CHECKS\n\n",
+		},
+		{
+			StartExecutingSvcChecks(),
+			"COMMAND [1439633040] START_EXECUTING_SVC_CHECKS\n\n",
+		},
+		{
+			StartObsessingOverHost("host_name"),
+			"COMMAND [1439633040] START_OBSESSING_OVER_HOST;host_name\n\n",
+		},
+		{
+			StartObsessingOverHostChecks(),
+			"COMMAND [1439633040] START_OBSESSING_OVER_HOST_CHECKS\n\n",
+		},
+		{
+			StartObsessingOverSvc("host_name", "service_description"),
+			"COMMAND [1439633040] START_OBSESSING_OVER_SVC;host_name;service_description\n\n",
+		},
+		{
+			StartObsessingOverSvcChecks(),
+			"COMMAND [1439633040] START_OBSESSING_OVER_SVC_CHECKS\n\n",
+		},
+		{
+			StopAcceptingPassiveHostChecks(),
+			"COMMAND [1439633040] STOP_ACCEPTING_PASSIVE_HOST_CHECKS\n\n",
+		},
+		{
+			StopAcceptingPassiveSvcChecks(),
+			"COMMAND [1439633040] STOP_ACCEPTING_PASSIVE_SVC_CHECKS\n\n",
+		},
+		{
+			StopExecutingHostChecks(),
+			"COMMAND [1439633040] STOP_EXECUTING_HOST_CHECKS\n\n",
+		},
+		{
+			StopExecutingSvcChecks(),
+			"COMMAND [1439633040] STOP_EXECUTING_SVC_CHECKS\n\n",
+		},
+		{
+			StopObsessingOverHost("host_name"),
+			"COMMAND [1439633040] STOP_OBSESSING_OVER_HOST;host_name\n\n",
+		},
+		{
+			StopObsessingOverHostChecks(),
+			"COMMAND [1439633040] STOP_OBSESSING_OVER_HOST_CHECKS\n\n",
+		},
+		{
+			StopObsessingOverSvc("host_name", "service_description"),
+			"COMMAND [1439633040] STOP_OBSESSING_OVER_SVC;host_name;service_description\n\n",
+		},
+		{
+			StopObsessingOverSvcChecks(),
+			"COMMAND [1439633040] STOP_OBSESSING_OVER_SVC_CHECKS\n\n",
+		},
+	} {
+		result := test.cmd.Timestamp(time.Unix(1439633040, 0)).String()
+
+		if err := test.cmd.Err(); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if result != test.expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		} else if pc, err := Commands.Parse(result); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if rt := pc.Command().String(); rt != result {
+			t.Logf("\nExpected %q\nbut got  %q\n", result, rt)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,477 @@
+package nagios
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+var downtimeColumns = []string{
+	"id",
+	"host_name",
+	"service_description",
+	"is_service",
+	"author",
+	"comment",
+	"entry_time",
+	"start_time",
+	"end_time",
+	"fixed",
+	"duration",
+	"triggered_by",
+}
+
+// Downtime represents a scheduled downtime.
+type Downtime struct {
+	ID                 int64
+	HostName           string
+	ServiceDescription string
+	IsService          bool
+	Author             string
+	Comment            string
+	EntryTime          time.Time
+	StartTime          time.Time
+	EndTime            time.Time
+	Fixed              bool
+	// Duration is the duration of flexible downtimes, starting when the object changes state between StartTime
+	// and EndTime.
+	Duration    time.Duration
+	TriggeredBy int64
+}
+
+// DowntimeOptions represents the settings of downtimes to schedule.
+type DowntimeOptions struct {
+	Start time.Time
+	End   time.Time
+	// Fixed downtimes last from Start to End, flexible ones lasting Duration starting when the object changes state
+	// between Start and End.
+	Fixed    bool
+	Duration time.Duration
+	// TriggerID is the identifier of the downtime triggering the scheduled ones, 0 if none.
+	TriggerID int64
+	Author    string
+	Comment   string
+}
+
+// Downtimes represents a downtimes management service, scheduling and cancelling downtimes and waiting for the
+// monitoring core to process the commands in order to report the identifiers of the created and removed downtimes.
+//
+// As identifiers are assigned by the monitoring core, created downtimes are looked for using their settings, the
+// ones already existing before sending the commands being ignored. Identical downtimes scheduled concurrently for
+// the same objects by other clients can still be reported instead of the created ones.
+type Downtimes struct {
+	client   *livestatus.Client
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// NewDowntimes creates a new downtimes management service using a given client.
+func NewDowntimes(c *livestatus.Client) *Downtimes {
+	return &Downtimes{
+		client:   c,
+		timeout:  DefaultVerifyTimeout,
+		interval: DefaultVerifyInterval,
+	}
+}
+
+// Timeout sets the maximum duration to wait for the monitoring core to process the commands.
+func (d *Downtimes) Timeout(timeout time.Duration) *Downtimes {
+	d.timeout = timeout
+	return d
+}
+
+// Interval sets the interval between two checks of the downtimes table while waiting for the commands to be
+// processed.
+func (d *Downtimes) Interval(interval time.Duration) *Downtimes {
+	d.interval = interval
+	return d
+}
+
+// List returns the active and future downtimes, as of the client clock.
+func (d *Downtimes) List() ([]Downtime, error) {
+	q := livestatus.NewQuery("downtimes").
+		Columns(downtimeColumns...).
+		FilterValue("end_time", ">=", d.client.Clock().Now())
+
+	return d.query(q)
+}
+
+// ScheduleHost schedules a downtime for a given host, and for all its services if withServices is true. It returns
+// the identifiers of the created downtimes.
+func (d *Downtimes) ScheduleHost(ctx context.Context, host string, withServices bool, opts DowntimeOptions) ([]int64,
+	error) {
+	targets := []downtimeTarget{{host: host}}
+
+	if withServices {
+		resp, err := d.client.Exec(livestatus.NewQuery("services").
+			Columns("host_name", "description").
+			FilterValue("host_name", "=", host))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range resp.Records {
+			service, err := r.GetString("description")
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, downtimeTarget{host: host, service: service})
+		}
+	}
+
+	return d.schedule(ctx, targets, opts)
+}
+
+// ScheduleHosts schedules a downtime for each host returned by a given query on the hosts table, which must
+// return the `name` column. It returns the identifiers of the created downtimes.
+func (d *Downtimes) ScheduleHosts(ctx context.Context, q *livestatus.Query, opts DowntimeOptions) ([]int64, error) {
+	resp, err := d.client.Exec(q)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []downtimeTarget{}
+	for _, r := range resp.Records {
+		host, err := r.GetString("name")
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, downtimeTarget{host: host})
+	}
+
+	return d.schedule(ctx, targets, opts)
+}
+
+// ScheduleServices schedules a downtime for each service returned by a given query on the services table, which
+// must return the `host_name` and `description` columns. It returns the identifiers of the created downtimes.
+func (d *Downtimes) ScheduleServices(ctx context.Context, q *livestatus.Query, opts DowntimeOptions) ([]int64,
+	error) {
+	resp, err := d.client.Exec(q)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []downtimeTarget{}
+	for _, r := range resp.Records {
+		host, err := r.GetString("host_name")
+		if err != nil {
+			return nil, err
+		}
+
+		service, err := r.GetString("description")
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, downtimeTarget{host: host, service: service})
+	}
+
+	return d.schedule(ctx, targets, opts)
+}
+
+// Cancel cancels the downtimes having the given identifiers. It returns the identifiers of the removed downtimes,
+// unknown ones being ignored.
+func (d *Downtimes) Cancel(ctx context.Context, ids ...int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return d.cancel(ctx, downtimesQuery(ids))
+}
+
+// CancelByAuthor cancels the downtimes scheduled by a given author. It returns the identifiers of the removed
+// downtimes.
+func (d *Downtimes) CancelByAuthor(ctx context.Context, author string) ([]int64, error) {
+	return d.cancel(ctx, livestatus.NewQuery("downtimes").
+		Columns(downtimeColumns...).
+		FilterValue("author", "=", author))
+}
+
+// CancelByComment cancels the downtimes whose comment matches a given regular expression. It returns the
+// identifiers of the removed downtimes.
+func (d *Downtimes) CancelByComment(ctx context.Context, pattern string) ([]int64, error) {
+	return d.cancel(ctx, livestatus.NewQuery("downtimes").
+		Columns(downtimeColumns...).
+		FilterValue("comment", "~", pattern))
+}
+
+// Extend changes the end time of the downtimes having the given identifiers. As downtimes can't be modified, each
+// of them is replaced by a new one having the same settings but the end time, the new downtime being scheduled
+// before the old one is removed. It returns the identifiers of the created downtimes.
+//
+// Cancelling a downtime removes the downtimes it triggers, thus these are replaced as well, the new ones being
+// triggered by the new downtime and keeping their end time. As flexible downtimes can't be told to be in effect,
+// extending flexible downtimes whose start time has passed fails, nothing being changed.
+func (d *Downtimes) Extend(ctx context.Context, end time.Time, ids ...int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	downtimes, err := d.query(downtimesQuery(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	now := d.client.Clock().Now()
+	for _, dt := range downtimes {
+		if !dt.Fixed && !dt.StartTime.After(now) {
+			return nil, fmt.Errorf("cannot extend flexible downtime %d: already started, thus possibly in effect", dt.ID)
+		}
+	}
+
+	created := []int64{}
+
+	for _, dt := range downtimes {
+		newIDs, err := d.replace(ctx, dt, end, dt.TriggeredBy)
+		created = append(created, newIDs...)
+		if err != nil {
+			return created, err
+		}
+	}
+
+	return created, nil
+}
+
+// replace replaces a downtime by a new one ending at a given time and triggered by a given downtime, along with
+// the downtimes it triggers. It returns the identifiers of the created downtimes, the replacing one first.
+func (d *Downtimes) replace(ctx context.Context, dt Downtime, end time.Time, triggerID int64) ([]int64, error) {
+	target := downtimeTarget{host: dt.HostName}
+	if dt.IsService {
+		target.service = dt.ServiceDescription
+	}
+
+	created, err := d.schedule(ctx, []downtimeTarget{target}, DowntimeOptions{
+		Start:     dt.StartTime,
+		End:       end,
+		Fixed:     dt.Fixed,
+		Duration:  dt.Duration,
+		TriggerID: triggerID,
+		Author:    dt.Author,
+		Comment:   dt.Comment,
+	})
+	if err != nil {
+		return created, err
+	}
+
+	// Replace the triggered downtimes before the old downtime removal deletes them
+	triggered, err := d.query(livestatus.NewQuery("downtimes").
+		Columns(downtimeColumns...).
+		FilterValue("triggered_by", "=", dt.ID))
+	if err != nil {
+		return created, err
+	}
+
+	for _, child := range triggered {
+		ids, err := d.replace(ctx, child, child.EndTime, created[0])
+		created = append(created, ids...)
+		if err != nil {
+			return created, err
+		}
+	}
+
+	if _, err := d.Cancel(ctx, dt.ID); err != nil {
+		return created, err
+	}
+
+	return created, nil
+}
+
+type downtimeTarget struct {
+	host    string
+	service string
+}
+
+func (d *Downtimes) schedule(ctx context.Context, targets []downtimeTarget, opts DowntimeOptions) ([]int64, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	now := d.client.Clock().Now()
+
+	// Downtimes identifiers are assigned by the monitoring core, thus look for the newly created ones
+	q := livestatus.NewQuery("downtimes").
+		Columns(downtimeColumns...).
+		FilterValue("entry_time", ">=", now.Truncate(time.Second)).
+		FilterValue("author", "=", opts.Author).
+		FilterValue("comment", "=", opts.Comment).
+		FilterValue("start_time", "=", opts.Start).
+		FilterValue("end_time", "=", opts.End)
+
+	// Ignore the matching downtimes existing before sending the commands (e.g. scheduled in the same second)
+	existing, err := d.query(q)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := map[int64]struct{}{}
+	for _, dt := range existing {
+		skip[dt.ID] = struct{}{}
+	}
+
+	batch := livestatus.NewCommandBatch()
+	pending := map[downtimeTarget]struct{}{}
+
+	for _, t := range targets {
+		var cmd *livestatus.Command
+		if t.service != "" {
+			cmd = ScheduleSvcDowntime(t.host, t.service, opts.Start, opts.End, opts.Fixed, int(opts.TriggerID),
+				opts.Duration, opts.Author, opts.Comment)
+		} else {
+			cmd = ScheduleHostDowntime(t.host, opts.Start, opts.End, opts.Fixed, int(opts.TriggerID), opts.Duration,
+				opts.Author, opts.Comment)
+		}
+
+		batch.Add(cmd.Timestamp(now))
+		pending[t] = struct{}{}
+	}
+
+	if _, err := d.client.Exec(batch); err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+
+	err = d.wait(ctx, func() (bool, error) {
+		downtimes, err := d.query(q)
+		if err != nil {
+			return false, err
+		}
+
+		for _, dt := range downtimes {
+			if _, ok := skip[dt.ID]; ok {
+				continue
+			}
+
+			t := downtimeTarget{host: dt.HostName}
+			if dt.IsService {
+				t.service = dt.ServiceDescription
+			}
+
+			if _, ok := pending[t]; ok {
+				ids = append(ids, dt.ID)
+				delete(pending, t)
+			}
+		}
+
+		return len(pending) == 0, nil
+	})
+	if err != nil {
+		return ids, fmt.Errorf("%d of %d downtimes not found: %w", len(pending), len(targets), err)
+	}
+
+	return ids, nil
+}
+
+func (d *Downtimes) cancel(ctx context.Context, q *livestatus.Query) ([]int64, error) {
+	downtimes, err := d.query(q)
+	if err != nil || len(downtimes) == 0 {
+		return nil, err
+	}
+
+	batch := livestatus.NewCommandBatch()
+	pending := map[int64]struct{}{}
+
+	for _, dt := range downtimes {
+		if dt.IsService {
+			batch.Add(DelSvcDowntime(int(dt.ID)))
+		} else {
+			batch.Add(DelHostDowntime(int(dt.ID)))
+		}
+		pending[dt.ID] = struct{}{}
+	}
+
+	if _, err := d.client.Exec(batch); err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+
+	err = d.wait(ctx, func() (bool, error) {
+		remaining, err := d.query(q)
+		if err != nil {
+			return false, err
+		}
+
+		left := map[int64]struct{}{}
+		for _, dt := range remaining {
+			left[dt.ID] = struct{}{}
+		}
+
+		for id := range pending {
+			if _, ok := left[id]; !ok {
+				ids = append(ids, id)
+				delete(pending, id)
+			}
+		}
+
+		return len(pending) == 0, nil
+	})
+	if err != nil {
+		return ids, fmt.Errorf("%d of %d downtimes not removed: %w", len(pending), len(downtimes), err)
+	}
+
+	return ids, nil
+}
+
+// wait calls fn until it returns true, an error, or the timeout expires.
+func (d *Downtimes) wait(ctx context.Context, fn func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	for {
+		if done, err := fn(); err != nil || done {
+			return err
+		}
+
+		if !sleepContext(ctx, d.interval) {
+			return ctx.Err()
+		}
+	}
+}
+
+func downtimesQuery(ids []int64) *livestatus.Query {
+	q := livestatus.NewQuery("downtimes").Columns(downtimeColumns...)
+	for _, id := range ids {
+		q.FilterValue("id", "=", id)
+	}
+	if len(ids) > 1 {
+		q.Or(len(ids))
+	}
+
+	return q
+}
+
+func (d *Downtimes) query(q *livestatus.Query) ([]Downtime, error) {
+	resp, err := d.client.Exec(q)
+	if err != nil {
+		return nil, err
+	}
+
+	downtimes := make([]Downtime, len(resp.Records))
+	for i, r := range resp.Records {
+		rr := r.Reader()
+
+		downtimes[i] = Downtime{
+			ID:                 rr.Int("id"),
+			HostName:           rr.String("host_name"),
+			ServiceDescription: rr.String("service_description"),
+			IsService:          rr.Bool("is_service"),
+			Author:             rr.String("author"),
+			Comment:            rr.String("comment"),
+			EntryTime:          rr.Time("entry_time"),
+			StartTime:          rr.Time("start_time"),
+			EndTime:            rr.Time("end_time"),
+			Fixed:              rr.Bool("fixed"),
+			Duration:           time.Duration(rr.Int("duration")) * time.Second,
+			TriggeredBy:        rr.Int("triggered_by"),
+		}
+
+		if err := rr.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return downtimes, nil
+}
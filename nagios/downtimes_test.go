@@ -0,0 +1,434 @@
+package nagios
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+func Test_DowntimesScheduleHost(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		switch {
+		case strings.HasPrefix(req, "GET services\n"):
+			return `[["host1","svc1"],["host1","svc2"]]`
+		case len(commands) == 0:
+			return `[]`
+		}
+
+		return `[
+			[1,"host1","",0,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0],
+			[2,"host1","svc1",1,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0],
+			[3,"host2","",0,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0],
+			[4,"host1","svc2",1,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0]
+		]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	ids, err := NewDowntimes(c).Interval(time.Millisecond).ScheduleHost(context.Background(), "host1", true,
+		DowntimeOptions{
+			Start:    time.Unix(1439633040, 0),
+			End:      time.Unix(1439636640, 0),
+			Fixed:    true,
+			Duration: time.Hour,
+			Author:   "author1",
+			Comment:  "comment1",
+		})
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if expected := []int64{1, 2, 4}; !reflect.DeepEqual(ids, expected) {
+		t.Logf("\nExpected %v\nbut got  %v\n", expected, ids)
+		t.Fail()
+	}
+
+	expected := []string{
+		"SCHEDULE_HOST_DOWNTIME;host1;1439633040;1439636640;1;0;3600;author1;comment1",
+		"SCHEDULE_SVC_DOWNTIME;host1;svc1;1439633040;1439636640;1;0;3600;author1;comment1",
+		"SCHEDULE_SVC_DOWNTIME;host1;svc2;1439633040;1439636640;1;0;3600;author1;comment1",
+	}
+	if !reflect.DeepEqual(core.commands, expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, core.commands)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesScheduleTimeout(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		switch {
+		case strings.HasPrefix(req, "GET hosts\n"):
+			return `[["host1"],["host2"]]`
+		case len(commands) == 0:
+			return `[]`
+		}
+		return `[[1,"host1","",0,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0]]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	q := livestatus.NewQuery("hosts").Columns("name").Filter("name ~ ^host")
+
+	ids, err := NewDowntimes(c).Interval(time.Millisecond).Timeout(20*time.Millisecond).
+		ScheduleHosts(context.Background(), q, DowntimeOptions{
+			Start:   time.Unix(1439633040, 0),
+			End:     time.Unix(1439636640, 0),
+			Fixed:   true,
+			Author:  "author1",
+			Comment: "comment1",
+		})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", context.DeadlineExceeded, err)
+		t.Fail()
+	}
+
+	if expected := []int64{1}; !reflect.DeepEqual(ids, expected) {
+		t.Logf("\nExpected %v\nbut got  %v\n", expected, ids)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesCancelByAuthor(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		if !strings.Contains(req, "Filter: author = author1\n") || len(commands) > 0 {
+			return `[]`
+		}
+
+		return `[
+			[1,"host1","",0,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0],
+			[2,"host1","svc1",1,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0]
+		]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	ids, err := NewDowntimes(c).Interval(time.Millisecond).CancelByAuthor(context.Background(), "author1")
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if len(ids) != 2 || ids[0]+ids[1] != 3 {
+		t.Logf("\nExpected [1 2]\nbut got  %v\n", ids)
+		t.Fail()
+	}
+
+	expected := []string{"DEL_HOST_DOWNTIME;1", "DEL_SVC_DOWNTIME;2"}
+	if !reflect.DeepEqual(core.commands, expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, core.commands)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesExtend(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		switch {
+		case strings.Contains(req, "Filter: end_time = 1439640240\n") && len(commands) > 0:
+			return `[[6,"host1","svc1",1,"author1","comment1",1439633100,1439633040,1439640240,0,600,0]]`
+		case strings.Contains(req, "Filter: id = 5\n") && len(commands) < 2:
+			return `[[5,"host1","svc1",1,"author1","comment1",1439633000,1439633040,1439636640,0,600,0]]`
+		}
+
+		return `[]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633000, 0)})
+	defer c.Close()
+
+	ids, err := NewDowntimes(c).Interval(time.Millisecond).Extend(context.Background(), time.Unix(1439640240, 0), 5)
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if expected := []int64{6}; !reflect.DeepEqual(ids, expected) {
+		t.Logf("\nExpected %v\nbut got  %v\n", expected, ids)
+		t.Fail()
+	}
+
+	expected := []string{
+		"SCHEDULE_SVC_DOWNTIME;host1;svc1;1439633040;1439640240;0;0;600;author1;comment1",
+		"DEL_SVC_DOWNTIME;5",
+	}
+	if !reflect.DeepEqual(core.commands, expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, core.commands)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesExtendTriggered(t *testing.T) {
+	sent := func(commands []string, cmd string) bool {
+		for _, c := range commands {
+			if c == cmd {
+				return true
+			}
+		}
+		return false
+	}
+
+	core := &testCore{answer: func(req string, commands []string) string {
+		switch {
+		case strings.Contains(req, "Filter: id = 5\n") && !sent(commands, "DEL_HOST_DOWNTIME;5"):
+			return `[[5,"host1","",0,"author1","comment1",1439633000,1439633040,1439636640,1,3600,0]]`
+		case strings.Contains(req, "Filter: id = 7\n") && !sent(commands, "DEL_SVC_DOWNTIME;7"):
+			return `[[7,"host1","svc1",1,"author1","comment1",1439633000,1439633040,1439636640,0,600,5]]`
+		case strings.Contains(req, "Filter: triggered_by = 5\n") && !sent(commands, "DEL_HOST_DOWNTIME;5"):
+			return `[[7,"host1","svc1",1,"author1","comment1",1439633000,1439633040,1439636640,0,600,5]]`
+		case strings.Contains(req, "Filter: end_time = 1439640240\n") && len(commands) > 0:
+			return `[[6,"host1","",0,"author1","comment1",1439633000,1439633040,1439640240,1,3600,0]]`
+		case strings.Contains(req, "Filter: end_time = 1439636640\n") && len(commands) > 1:
+			return `[[8,"host1","svc1",1,"author1","comment1",1439633000,1439633040,1439636640,0,600,6]]`
+		}
+
+		return `[]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633000, 0)})
+	defer c.Close()
+
+	ids, err := NewDowntimes(c).Interval(time.Millisecond).Extend(context.Background(), time.Unix(1439640240, 0), 5)
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if expected := []int64{6, 8}; !reflect.DeepEqual(ids, expected) {
+		t.Logf("\nExpected %v\nbut got  %v\n", expected, ids)
+		t.Fail()
+	}
+
+	// The triggered downtime is replaced by a downtime triggered by the new one
+	expected := []string{
+		"SCHEDULE_HOST_DOWNTIME;host1;1439633040;1439640240;1;0;3600;author1;comment1",
+		"SCHEDULE_SVC_DOWNTIME;host1;svc1;1439633040;1439636640;0;6;600;author1;comment1",
+		"DEL_SVC_DOWNTIME;7",
+		"DEL_HOST_DOWNTIME;5",
+	}
+	if !reflect.DeepEqual(core.commands, expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, core.commands)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesExtendStarted(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		return `[[5,"host1","svc1",1,"author1","comment1",1439633000,1439633040,1439636640,0,600,0]]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633100, 0)})
+	defer c.Close()
+
+	_, err := NewDowntimes(c).Interval(time.Millisecond).Extend(context.Background(), time.Unix(1439640240, 0), 5)
+	if err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+
+	core.mu.Lock()
+	defer core.mu.Unlock()
+
+	if len(core.commands) > 0 {
+		t.Logf("\nExpected no command\nbut got  %q\n", core.commands)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesScheduleExisting(t *testing.T) {
+	// An identical downtime already exists, its identifier must not be reported
+	core := &testCore{answer: func(req string, commands []string) string {
+		if len(commands) == 0 {
+			return `[[1,"host1","",0,"author1","comment1",1439633040,1439633040,1439636640,1,0,0]]`
+		}
+
+		return `[
+			[1,"host1","",0,"author1","comment1",1439633040,1439633040,1439636640,1,0,0],
+			[2,"host1","",0,"author1","comment1",1439633040,1439633040,1439636640,1,0,0]
+		]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	ids, err := NewDowntimes(c).Interval(time.Millisecond).ScheduleHost(context.Background(), "host1", false,
+		DowntimeOptions{
+			Start:   time.Unix(1439633040, 0),
+			End:     time.Unix(1439636640, 0),
+			Fixed:   true,
+			Author:  "author1",
+			Comment: "comment1",
+		})
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if expected := []int64{2}; !reflect.DeepEqual(ids, expected) {
+		t.Logf("\nExpected %v\nbut got  %v\n", expected, ids)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesList(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		if !strings.Contains(req, "Filter: end_time >= ") {
+			return `[]`
+		}
+
+		return `[[2,"host1","svc1",1,"author1","comment1",1439633000,1439633040,1439636640,0,600,1]]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	downtimes, err := NewDowntimes(c).List()
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	expected := []Downtime{{
+		ID:                 2,
+		HostName:           "host1",
+		ServiceDescription: "svc1",
+		IsService:          true,
+		Author:             "author1",
+		Comment:            "comment1",
+		EntryTime:          time.Unix(1439633000, 0),
+		StartTime:          time.Unix(1439633040, 0),
+		EndTime:            time.Unix(1439636640, 0),
+		Duration:           10 * time.Minute,
+		TriggeredBy:        1,
+	}}
+	if !reflect.DeepEqual(downtimes, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, downtimes)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesCancelMalformed(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		return `[["1","host1","",0,"author1","comment1",1439633040,1439633040,1439636640,1,3600,0]]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	ids, err := NewDowntimes(c).Interval(time.Millisecond).CancelByAuthor(context.Background(), "author1")
+	if err == nil {
+		t.Logf("\nExpected an error\nbut got  %v\n", ids)
+		t.Fail()
+	}
+
+	core.mu.Lock()
+	defer core.mu.Unlock()
+
+	if len(core.commands) > 0 {
+		t.Logf("\nExpected no command\nbut got  %q\n", core.commands)
+		t.Fail()
+	}
+}
+
+func Test_DowntimesClock(t *testing.T) {
+	core := &testCore{answer: func(req string, commands []string) string {
+		switch {
+		case strings.Contains(req, "Filter: end_time >= 1439633040\n") && len(commands) == 0:
+			return `[[2,"host1","",0,"author1","comment1",1439633000,1439633040,1439636640,1,0,0]]`
+		case strings.Contains(req, "Filter: entry_time >= 1439633040\n") && len(commands) > 0:
+			return `[[3,"host2","",0,"author1","comment1",1439633040,1439633040,1439636640,1,0,0]]`
+		}
+
+		return `[]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633040, 0)})
+	defer c.Close()
+
+	d := NewDowntimes(c).Interval(time.Millisecond)
+
+	downtimes, err := d.List()
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	} else if len(downtimes) != 1 || downtimes[0].ID != 2 {
+		t.Logf("\nExpected downtime 2\nbut got  %#v\n", downtimes)
+		t.Fail()
+	}
+
+	ids, err := d.ScheduleHost(context.Background(), "host2", false, DowntimeOptions{
+		Start:   time.Unix(1439633040, 0),
+		End:     time.Unix(1439636640, 0),
+		Fixed:   true,
+		Author:  "author1",
+		Comment: "comment1",
+	})
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if expected := []int64{3}; !reflect.DeepEqual(ids, expected) {
+		t.Logf("\nExpected %v\nbut got  %v\n", expected, ids)
+		t.Fail()
+	}
+
+	core.mu.Lock()
+	defer core.mu.Unlock()
+
+	commands := []string{}
+	for _, req := range core.requests {
+		if strings.HasPrefix(req, "COMMAND ") {
+			commands = append(commands, req)
+		}
+	}
+
+	expected := []string{
+		"COMMAND [1439633040] SCHEDULE_HOST_DOWNTIME;host2;1439633040;1439636640;1;0;0;author1;comment1\n\n",
+	}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, commands)
+		t.Fail()
+	}
+}
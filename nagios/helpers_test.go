@@ -0,0 +1,112 @@
+package nagios
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testHandler func(req string) (int, string)
+
+func newTestServer(t *testing.T, handler testHandler) (string, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go serveTestConn(conn, handler)
+		}
+	}()
+
+	return l.Addr().String(), func() { l.Close() }
+}
+
+func serveTestConn(conn net.Conn, handler testHandler) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	for {
+		req := ""
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			req += line
+			if line == "\n" {
+				break
+			}
+		}
+
+		if strings.HasPrefix(req, "COMMAND ") {
+			handler(req)
+			continue
+		}
+
+		status, body := handler(req)
+		fmt.Fprintf(conn, "%3d %11d\n%s", status, len(body), body)
+
+		if !strings.Contains(req, "KeepAlive: on\n") {
+			return
+		}
+	}
+}
+
+// testCore represents a minimal monitoring core, answering queries using a given function and recording the
+// received requests and commands.
+type testCore struct {
+	mu sync.Mutex
+	// requests contains the raw requests, commands included.
+	requests []string
+	// commands contains the commands text, without their timestamp.
+	commands []string
+	answer   func(req string, commands []string) string
+}
+
+func (c *testCore) handle(req string) (int, string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.requests = append(c.requests, req)
+
+	if strings.HasPrefix(req, "COMMAND ") {
+		// Strip the timestamp and the trailing empty line
+		c.commands = append(c.commands, strings.TrimSpace(req[strings.Index(req, "] ")+2:]))
+		return 0, ""
+	}
+
+	return 200, c.answer(req, c.commands)
+}
+
+// newTestCore creates a new test core answering queries with the given bodies in turn, the last one being
+// repeated.
+func newTestCore(bodies ...string) *testCore {
+	return &testCore{answer: func(req string, commands []string) string {
+		body := bodies[0]
+		if len(bodies) > 1 {
+			bodies = bodies[1:]
+		}
+
+		return body
+	}}
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c fakeClock) Now() time.Time {
+	return c.now
+}
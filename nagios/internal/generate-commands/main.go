@@ -1,282 +1,474 @@
+// Command generate-commands generates a commands package and its tests from an external commands specification
+// file.
 package main
 
 import (
 	"bytes"
 	"flag"
 	"fmt"
-	"net/http"
+	"go/format"
 	"os"
-	"regexp"
-	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
-	wordwrap "github.com/mitchellh/go-wordwrap"
-	"golang.org/x/net/html"
+	"github.com/vbatoufflet/go-livestatus/nagios/internal/spec"
 )
 
 const (
-	commandsIndex     = "https://old.nagios.org/developerinfo/externalcommands/"
-	commandsURLPrefix = "commandinfo.php?command_id="
+	commentWidth = 116
+	testTime     = 1439633040
 )
 
-var (
-	commandArgRegexp = regexp.MustCompile("<([a-z_]+)>")
-
-	commandTypes = map[string]string{
-		"author":                  "string",
-		"check_attempts":          "int",
-		"check_command":           "string",
-		"check_interval":          "time.Duration",
-		"check_time":              "time.Time",
-		"check_timeperiod":        "string",
-		"comment":                 "string",
-		"comment_id":              "int",
-		"contact_name":            "string",
-		"contactgroup_name":       "string",
-		"delete":                  "bool",
-		"downtime_id":             "int",
-		"duration":                "time.Duration",
-		"end_time":                "time.Time",
-		"event_handler_command":   "string",
-		"file_name":               "string",
-		"fixed":                   "bool",
-		"host_name":               "string",
-		"hostgroup_name":          "string",
-		"notification_number":     "int",
-		"notification_time":       "time.Time",
-		"notification_timeperiod": "string",
-		"notify":                  "bool",
-		"options":                 "int",
-		"persistent":              "bool",
-		"plugin_output":           "string",
-		"return_code":             "int",
-		"service_description":     "string",
-		"servicegroup_name":       "string",
-		"start_time":              "time.Time",
-		"status_code":             "int",
-		"sticky":                  "bool",
-		"timeperiod":              "string",
-		"trigger_id":              "int",
-		"value":                   "string",
-		"varname":                 "string",
-		"varvalue":                "string",
-	}
-)
-
-func main() {
-	var outputPath string
-
-	flag.StringVar(&outputPath, "o", "", "output file path")
-	flag.Parse()
+var argTypes = map[string]string{
+	spec.TypeAckSticky:           "ArgAckSticky",
+	spec.TypeBool:                "ArgBool",
+	spec.TypeDuration:            "ArgDuration",
+	spec.TypeHostState:           "ArgHostState",
+	spec.TypeInt:                 "ArgInt",
+	spec.TypeNotificationOptions: "ArgNotificationOptions",
+	spec.TypeServiceState:        "ArgServiceState",
+	spec.TypeString:              "ArgString",
+	spec.TypeTime:                "ArgTime",
+}
 
-	if outputPath == "" {
-		die("missing -o mandatory option")
-	}
+var goTypes = map[string]string{
+	spec.TypeAckSticky:           "AckSticky",
+	spec.TypeBool:                "bool",
+	spec.TypeDuration:            "time.Duration",
+	spec.TypeHostState:           "HostState",
+	spec.TypeInt:                 "int",
+	spec.TypeNotificationOptions: "NotificationOptions",
+	spec.TypeServiceState:        "ServiceState",
+	spec.TypeString:              "string",
+	spec.TypeTime:                "time.Time",
+}
 
-	f, err := os.OpenFile(outputPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
-	if err != nil {
-		die("failed to create output file: %s", err)
-	}
-	defer f.Close()
+// enumTypes lists the types defined in the nagios package, passed to the commands as their integer value.
+var enumTypes = map[string]bool{
+	spec.TypeAckSticky:           true,
+	spec.TypeHostState:           true,
+	spec.TypeNotificationOptions: true,
+	spec.TypeServiceState:        true,
+}
 
-	template.Must(template.New("").Parse(`// Generated by go generate; DO NOT EDIT.
+var commandsTemplate = template.Must(template.New("").Parse(`// Generated by go generate; DO NOT EDIT.
 
-package nagios
+package {{ .Package }}
 
 import (
+{{- if .NeedsTime }}
 	"time"
 
+{{ end }}
 	livestatus "github.com/vbatoufflet/go-livestatus"
+{{- if ne .Package "nagios" }}
+	"github.com/vbatoufflet/go-livestatus/nagios"
+{{- end }}
 )
 {{- range .Commands }}
 
-// {{ .FuncName }} creates a new "{{ .Name }}" Nagios command.
+// {{ .FuncName }} creates a new "{{ .Name }}" {{ $.Core }} command.
+//
+{{ .Comment }}
+{{- if .Supported }}
 //
-{{ .Description }}
+// Supported by {{ .SupportedBy }}.
+{{- end }}
 {{- if .Args }}
 func {{ .FuncName }}(
 {{- range .Args }}
-	{{ .Name }} {{ .Type }},
+	{{ .Name }} {{ .GoType }},
 {{- end }}
 ) *livestatus.Command {
 {{- else }}
 func {{ .FuncName }}() *livestatus.Command {
 {{- end }}
-	return livestatus.NewCommand(
-		"{{ .Name }}",
+{{- if .Optional }}
+	cmd := livestatus.NewCommand("{{ .Name }}")
+{{- range .Mandatory }}.
+		{{ if .Required }}RequiredArg{{ else }}Arg{{ end }}({{ .Value }})
+{{- end }}
+
+	if {{ .Optional.NotZero }} {
+		cmd.Arg({{ .Optional.Value }})
+	}
+
+	return cmd
+{{- else }}
+	return livestatus.NewCommand("{{ .Name }}")
+{{- range .Args }}.
+		{{ if .Required }}RequiredArg{{ else }}Arg{{ end }}({{ .Value }})
+{{- end }}
+{{- end }}
+}
+{{- end }}
+
+{{- $p := .CatalogPrefix }}
+
+// Commands is the catalog of the {{ .Core }} commands, used to parse commands text representations.
+var Commands = {{ $p }}Catalog{
+{{- range .Commands }}
+	"{{ .Name }}": {
+		Name: "{{ .Name }}",
+{{- if .Args }}
+		Args: []{{ $p }}ArgSpec{
 {{- range .Args }}
-		stringifyArg("{{ .Name }}", "{{ .Type }}", {{ .Name }}),
+			{Name: "{{ .Name }}", Type: {{ $p }}{{ .ArgType }}{{ if .Required }}, Required: true{{ end }}
+{{- if .Optional }}, Optional: true{{ end }}},
+{{- end }}
+		},
+{{- end }}
+	},
 {{- end }}
-	)
 }
+`))
+
+var testsTemplate = template.Must(template.New("").Parse(`// Generated by go generate; DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+{{- if .TestsImportNagios }}
+	"github.com/vbatoufflet/go-livestatus/nagios"
 {{- end }}
-`,
-	)).Execute(f, struct{ Commands []*command }{Commands: fetchCommands()})
+)
+
+func Test_Commands(t *testing.T) {
+	for _, test := range []struct {
+		cmd      *livestatus.Command
+		expected string
+	}{
+{{- range .Commands }}
+		{
+			{{ .FuncName }}({{ range $i, $a := .Args }}{{ if $i }}, {{ end }}{{ .TestValue }}{{ end }}),
+			{{ printf "%q" (.TestOutput true) }},
+		},
+{{- if .Optional }}
+		{
+			{{ .FuncName }}({{ range $i, $a := .Mandatory }}{{ if $i }}, {{ end }}{{ .TestValue }}{{ end }}, {{ .Optional.TestZeroValue }}),
+			{{ printf "%q" (.TestOutput false) }},
+		},
+{{- end }}
+{{- end }}
+	} {
+		result := test.cmd.Timestamp(time.Unix({{ .TestTime }}, 0)).String()
+
+		if err := test.cmd.Err(); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if result != test.expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		} else if pc, err := Commands.Parse(result); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if rt := pc.Command().String(); rt != result {
+			t.Logf("\nExpected %q\nbut got  %q\n", result, rt)
+			t.Fail()
+		}
+	}
 }
+`))
 
-func fetchCommands() []*command {
+func main() {
 	var (
-		id  int
-		err error
+		specPath   string
+		outputPath string
+		testPath   string
 	)
 
-	commands := []*command{}
-	commandsMap := map[string]struct{}{}
+	flag.StringVar(&specPath, "spec", "", "commands specification file path")
+	flag.StringVar(&outputPath, "o", "", "output file path")
+	flag.StringVar(&testPath, "test", "", "output test file path")
+	flag.Parse()
+
+	if specPath == "" {
+		die("missing -spec mandatory option")
+	} else if outputPath == "" {
+		die("missing -o mandatory option")
+	}
 
-	resp, err := http.Get(commandsIndex)
+	s, err := spec.Load(specPath)
 	if err != nil {
-		die("failed to fetch external commands: %s", err)
+		die("failed to load specification: %s", err)
 	}
-	defer resp.Body.Close()
-
-	t := html.NewTokenizer(resp.Body)
-	for {
-		tt := t.Next()
-		switch tt {
-		case html.ErrorToken:
-			goto stop
-
-		case html.StartTagToken:
-			id = 0
-
-			if tag, _ := t.TagName(); bytes.Equal(tag, []byte("a")) {
-				for {
-					key, val, moreAttr := t.TagAttr()
-					if bytes.Equal(key, []byte("href")) && bytes.HasPrefix(val, []byte(commandsURLPrefix)) {
-						id, err = strconv.Atoi(string(bytes.TrimPrefix(val, []byte(commandsURLPrefix))))
-						if err != nil {
-							die("failed to parse integer: %s", err)
-						}
-					} else if !moreAttr {
-						break
-					}
-				}
-			}
 
-		case html.EndTagToken:
-			id = 0
+	data := newTemplateData(s)
 
-		case html.TextToken:
-			if id > 0 {
-				name := string(t.Text())
+	render(commandsTemplate, data, outputPath)
+	if testPath != "" {
+		render(testsTemplate, data, testPath)
+	}
+}
 
-				if _, ok := commandsMap[name]; ok {
-					fmt.Fprintf(os.Stderr, "Warning: skipping already defined %q command\n", name)
-					continue
-				}
+func render(tmpl *template.Template, data interface{}, path string) {
+	buf := bytes.NewBuffer(nil)
+	if err := tmpl.Execute(buf, data); err != nil {
+		die("failed to execute template: %s", err)
+	}
 
-				commands = append(commands, &command{
-					ID:       id,
-					Name:     name,
-					FuncName: normalizeFuncName(name),
-				})
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		die("failed to format %s: %s", path, err)
+	}
 
-				commandsMap[name] = struct{}{}
-			}
-		}
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		die("failed to write %s: %s", path, err)
+	}
+}
+
+func die(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
+	os.Exit(1)
+}
+
+type templateData struct {
+	Package           string
+	CatalogPrefix     string
+	Core              string
+	Commands          []*command
+	NeedsTime         bool
+	TestsImportNagios bool
+	TestTime          int64
+}
+
+type command struct {
+	*spec.Command
+	FuncName string
+	Comment  string
+	Args     []*arg
+}
+
+type arg struct {
+	*spec.Arg
+	GoType  string
+	ArgType string
+	prefix  string
+}
+
+func newTemplateData(s *spec.Spec) *templateData {
+	data := &templateData{
+		Package:  s.Package,
+		Core:     s.Core,
+		TestTime: testTime,
 	}
-stop:
 
-	for _, cmd := range commands {
-		var (
-			formatFound bool
-			descFound   bool
-		)
+	// Catalog types are defined in the nagios package
+	if s.Package != "nagios" {
+		data.CatalogPrefix = "nagios."
+	}
 
-		resp, err := http.Get(fmt.Sprintf("%s%s%d", commandsIndex, commandsURLPrefix, cmd.ID))
-		if err != nil {
-			die("failed to fetch external commands: %s", err)
+	for _, c := range s.Commands {
+		cmd := &command{
+			Command:  c,
+			FuncName: spec.FuncName(c.Name),
+			Comment:  comment(c.Description),
 		}
-		defer resp.Body.Close()
-
-		t := html.NewTokenizer(resp.Body)
-		for {
-			tt := t.Next()
-			switch tt {
-			case html.ErrorToken:
-				goto next
-
-			case html.TextToken:
-				text := strings.TrimSpace(string(t.Text()))
-
-				if len(text) == 0 {
-					continue
-				} else if text == "Command Format:" {
-					formatFound = true
-				} else if text == "Description:" {
-					descFound = true
-				} else if formatFound {
-					for _, m := range commandArgRegexp.FindAllStringSubmatch(text, -1) {
-						// FIXME: there are typos and duplicates for both "CHANGE_HOST_CHECK_TIMEPERIOD" and
-						// "SCHEDULE_SVC_DOWNTIME", see:
-						//  * https://old.nagios.org/developerinfo/externalcommands/commandinfo.php?command_id=119
-						//  * https://old.nagios.org/developerinfo/externalcommands/commandinfo.php?command_id=133
-						//  * https://old.nagios.org/developerinfo/externalcommands/commandinfo.php?command_id=138
-						if m[1] == "check_timeperod" {
-							m[1] = "check_timeperiod"
-						} else if m[1] == "service_desription" {
-							m[1] = "service_description"
-						}
-
-						typ := "interface{}"
-						if v, ok := commandTypes[m[1]]; ok {
-							typ = v
-						}
-
-						cmd.Args = append(cmd.Args, commandArg{
-							Name: m[1],
-							Type: typ,
-						})
-					}
-					formatFound = false
-				} else if descFound {
-					text = strings.Replace(text, "  ", "\n\n", -1)
-
-					lines := []string{}
-					for _, line := range strings.Split(wordwrap.WrapString(text, 116), "\n") {
-						if line == "" {
-							line = "//"
-						} else {
-							line = "// " + line
-						}
-
-						lines = append(lines, line)
-					}
-
-					cmd.Description = strings.Join(lines, "\n")
-					descFound = false
+
+		for _, a := range c.Args {
+			ca := &arg{
+				Arg:     a,
+				GoType:  goTypes[a.Type],
+				ArgType: argTypes[a.Type],
+				prefix:  data.CatalogPrefix,
+			}
+
+			// Intervals durations are expressed in interval_length units instead of seconds
+			if a.Type == spec.TypeDuration && a.Unit == spec.UnitIntervals {
+				ca.GoType = "Intervals"
+				ca.ArgType = "ArgIntervals"
+			}
+
+			if ca.isNagiosType() {
+				ca.GoType = data.CatalogPrefix + ca.GoType
+				if data.CatalogPrefix != "" {
+					data.TestsImportNagios = true
 				}
+			} else if strings.HasPrefix(ca.GoType, "time.") {
+				data.NeedsTime = true
 			}
+
+			cmd.Args = append(cmd.Args, ca)
 		}
-	next:
+
+		data.Commands = append(data.Commands, cmd)
 	}
 
-	return commands
+	return data
 }
 
-func die(format string, args ...interface{}) {
-	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
-	os.Exit(1)
+// Optional returns the optional trailing argument of the command, or nil if none.
+func (c *command) Optional() *arg {
+	if n := len(c.Args); n > 0 && c.Args[n-1].Optional {
+		return c.Args[n-1]
+	}
+
+	return nil
 }
 
-func normalizeFuncName(input string) string {
-	parts := []string{}
-	for _, part := range strings.Split(input, "_") {
-		parts = append(parts, string(part[0])+strings.ToLower(part[1:]))
+// Mandatory returns the arguments of the command always present in its text.
+func (c *command) Mandatory() []*arg {
+	if c.Optional() != nil {
+		return c.Args[:len(c.Args)-1]
 	}
-	return strings.Join(parts, "")
+
+	return c.Args
 }
 
-type command struct {
-	ID          int
-	Name        string
-	FuncName    string
-	Description string
-	Args        []commandArg
+// SupportedBy returns the list of cores supporting the command, as rendered in the documentation.
+func (c *command) SupportedBy() string {
+	return strings.Join(c.Supported, ", ")
+}
+
+// isNagiosType returns whether the argument Go type is defined in the nagios package.
+func (a *arg) isNagiosType() bool {
+	return enumTypes[a.Type] || a.isIntervals()
+}
+
+func (a *arg) isIntervals() bool {
+	return a.Type == spec.TypeDuration && a.Unit == spec.UnitIntervals
+}
+
+// Value returns the Go expression of the value passed to livestatus.Command for the argument.
+func (a *arg) Value() string {
+	switch {
+	case enumTypes[a.Type]:
+		return fmt.Sprintf("int(%s)", a.Name)
+	case a.isIntervals():
+		return fmt.Sprintf("float64(%s)", a.Name)
+	}
+
+	return a.Name
 }
 
-type commandArg struct {
-	Name string
-	Type string
+// NotZero returns the Go expression checking that the argument doesn't have its zero value.
+func (a *arg) NotZero() string {
+	switch {
+	case a.Type == spec.TypeTime:
+		return fmt.Sprintf("!%s.IsZero()", a.Name)
+	case a.Type == spec.TypeBool:
+		return a.Name
+	case a.Type == spec.TypeString:
+		return fmt.Sprintf("%s != \"\"", a.Name)
+	}
+
+	return fmt.Sprintf("%s != 0", a.Name)
+}
+
+// TestZeroValue returns the Go expression of the zero value of the argument in generated tests.
+func (a *arg) TestZeroValue() string {
+	switch {
+	case a.Type == spec.TypeTime:
+		return "time.Time{}"
+	case a.Type == spec.TypeBool:
+		return "false"
+	case a.Type == spec.TypeString:
+		return `""`
+	}
+
+	return "0"
+}
+
+// TestValue returns the Go expression of the value passed for the argument in generated tests.
+func (a *arg) TestValue() string {
+	switch a.Type {
+	case spec.TypeAckSticky:
+		return a.prefix + "AckStickyUntilOK"
+	case spec.TypeHostState:
+		return a.prefix + "HostDown"
+	case spec.TypeNotificationOptions:
+		return a.prefix + "NotificationBroadcast | " + a.prefix + "NotificationForced"
+	case spec.TypeServiceState:
+		return a.prefix + "ServiceCritical"
+	}
+
+	switch {
+	case a.isIntervals():
+		return a.prefix + "ToIntervals(5*time.Minute, 0)"
+	case a.Type == spec.TypeBool:
+		return "true"
+	case a.Type == spec.TypeDuration:
+		return "5 * time.Minute"
+	case a.Type == spec.TypeInt:
+		return "1"
+	case a.Type == spec.TypeTime:
+		return fmt.Sprintf("time.Unix(%d, 0)", testTime)
+	}
+
+	return fmt.Sprintf("%q", a.Name)
+}
+
+// TestOutput returns the command expected text in generated tests, the optional argument being included if
+// withOptional is true.
+func (c *command) TestOutput(withOptional bool) string {
+	s := fmt.Sprintf("COMMAND [%d] %s", testTime, c.Name)
+
+	args := c.Args
+	if !withOptional {
+		args = c.Mandatory()
+	}
+
+	for _, a := range args {
+		switch a.Type {
+		case spec.TypeAckSticky:
+			s += ";2"
+		case spec.TypeHostState:
+			s += ";1"
+		case spec.TypeNotificationOptions:
+			s += ";3"
+		case spec.TypeServiceState:
+			s += ";2"
+		case spec.TypeBool:
+			s += ";1"
+		case spec.TypeDuration:
+			if a.isIntervals() {
+				s += ";5"
+				break
+			}
+			s += fmt.Sprintf(";%d", 5*time.Minute/time.Second)
+		case spec.TypeInt:
+			s += ";1"
+		case spec.TypeTime:
+			s += fmt.Sprintf(";%d", testTime)
+		default:
+			s += ";" + a.Name
+		}
+	}
+
+	return s + "\n\n"
+}
+
+// comment renders description paragraphs as a doc comment, wrapping lines.
+func comment(paragraphs []string) string {
+	lines := []string{}
+
+	for i, p := range paragraphs {
+		if i > 0 {
+			lines = append(lines, "//")
+		}
+
+		line := ""
+		for _, word := range strings.Fields(p) {
+			if line != "" && len(line)+1+len(word) > commentWidth {
+				lines = append(lines, "// "+line)
+				line = ""
+			}
+
+			if line != "" {
+				line += " "
+			}
+			line += word
+		}
+
+		if line != "" {
+			lines = append(lines, "// "+line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
 }
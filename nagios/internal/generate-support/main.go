@@ -0,0 +1,77 @@
+// Command generate-support generates the commands support documentation from external commands specification
+// files.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/vbatoufflet/go-livestatus/nagios/internal/spec"
+)
+
+var supportTemplate = template.Must(template.New("").Funcs(template.FuncMap{
+	"join":     strings.Join,
+	"funcName": spec.FuncName,
+}).Parse(`<!-- Generated by go generate; DO NOT EDIT. -->
+
+# Commands support
+
+Commands are grouped by package, each package covering the command set of a given monitoring core. Packages
+only contain the commands specific to their core, the common ones being provided by the ` + "`nagios`" + ` package.
+{{ range .Specs }}
+## {{ .Package }}
+
+{{ .Core }} commands, supported by {{ join .Supported ", " }}.
+{{- range .Notes }}
+
+{{ . }}
+{{- end }}
+
+| Command | Function | Supported by |
+|---------|----------|--------------|
+{{- $spec := . }}
+{{- range .Commands }}
+| ` + "`{{ .Name }}`" + ` | ` + "`{{ $spec.Package }}.{{ funcName .Name }}`" + ` | {{ join ($spec.SupportedBy .) ", " }} |
+{{- end }}
+{{ end -}}
+`))
+
+func main() {
+	var outputPath string
+
+	flag.StringVar(&outputPath, "o", "", "output file path")
+	flag.Parse()
+
+	if outputPath == "" {
+		die("missing -o mandatory option")
+	} else if flag.NArg() == 0 {
+		die("missing specification files")
+	}
+
+	specs := []*spec.Spec{}
+	for _, path := range flag.Args() {
+		s, err := spec.Load(path)
+		if err != nil {
+			die("failed to load specification: %s", err)
+		}
+		specs = append(specs, s)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := supportTemplate.Execute(buf, struct{ Specs []*spec.Spec }{Specs: specs}); err != nil {
+		die("failed to execute template: %s", err)
+	}
+
+	if err := os.WriteFile(outputPath, buf.Bytes(), 0644); err != nil {
+		die("failed to write %s: %s", outputPath, err)
+	}
+}
+
+func die(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,262 @@
+// Command import-commands imports the Nagios external commands specification from the Nagios developer website.
+// It is intended to be run once, the resulting specification file being then maintained by hand.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vbatoufflet/go-livestatus/nagios/internal/spec"
+	"golang.org/x/net/html"
+)
+
+const (
+	commandsIndex     = "https://old.nagios.org/developerinfo/externalcommands/"
+	commandsURLPrefix = "commandinfo.php?command_id="
+)
+
+var (
+	commandArgRegexp = regexp.MustCompile("<([a-z_]+)>")
+
+	commandTypes = map[string]string{
+		"author":                  spec.TypeString,
+		"check_attempts":          spec.TypeInt,
+		"check_command":           spec.TypeString,
+		"check_interval":          spec.TypeDuration,
+		"check_time":              spec.TypeTime,
+		"check_timeperiod":        spec.TypeString,
+		"comment":                 spec.TypeString,
+		"comment_id":              spec.TypeInt,
+		"contact_name":            spec.TypeString,
+		"contactgroup_name":       spec.TypeString,
+		"delete":                  spec.TypeBool,
+		"downtime_id":             spec.TypeInt,
+		"duration":                spec.TypeDuration,
+		"end_time":                spec.TypeTime,
+		"event_handler_command":   spec.TypeString,
+		"file_name":               spec.TypeString,
+		"fixed":                   spec.TypeBool,
+		"host_name":               spec.TypeString,
+		"hostgroup_name":          spec.TypeString,
+		"notification_number":     spec.TypeInt,
+		"notification_time":       spec.TypeTime,
+		"notification_timeperiod": spec.TypeString,
+		"notify":                  spec.TypeBool,
+		"options":                 spec.TypeNotificationOptions,
+		"persistent":              spec.TypeBool,
+		"plugin_output":           spec.TypeString,
+		"return_code":             spec.TypeServiceState,
+		"service_description":     spec.TypeString,
+		"servicegroup_name":       spec.TypeString,
+		"start_time":              spec.TypeTime,
+		"status_code":             spec.TypeHostState,
+		"sticky":                  spec.TypeAckSticky,
+		"timeperiod":              spec.TypeString,
+		"trigger_id":              spec.TypeInt,
+		"value":                   spec.TypeString,
+		"varname":                 spec.TypeString,
+		"varvalue":                spec.TypeString,
+	}
+
+	requiredArgs = map[string]bool{
+		"contact_name":        true,
+		"contactgroup_name":   true,
+		"host_name":           true,
+		"hostgroup_name":      true,
+		"service_description": true,
+		"servicegroup_name":   true,
+	}
+
+	commandUnits = map[string]string{
+		"check_interval":    spec.UnitIntervals,
+		"check_time":        spec.UnitTimestamp,
+		"duration":          spec.UnitSeconds,
+		"end_time":          spec.UnitTimestamp,
+		"notification_time": spec.UnitTimestamp,
+		"start_time":        spec.UnitTimestamp,
+	}
+)
+
+func main() {
+	var outputPath string
+
+	flag.StringVar(&outputPath, "o", "", "output specification file path")
+	flag.Parse()
+
+	if outputPath == "" {
+		die("missing -o mandatory option")
+	}
+
+	commands := []*spec.Command{}
+	for _, cmd := range fetchCommands() {
+		commands = append(commands, &spec.Command{
+			Name:        cmd.Name,
+			Description: cmd.Description,
+			Args:        cmd.Args,
+		})
+	}
+
+	s := &spec.Spec{
+		Package:   "nagios",
+		Core:      "Nagios",
+		Supported: []string{"Nagios 3", "Nagios 4", "Naemon", "Icinga 1"},
+		Commands:  commands,
+	}
+	if err := s.Validate(); err != nil {
+		die("invalid specification: %s", err)
+	} else if err := s.Save(outputPath); err != nil {
+		die("failed to write specification: %s", err)
+	}
+}
+
+func fetchCommands() []*command {
+	var (
+		id  int
+		err error
+	)
+
+	commands := []*command{}
+	commandsMap := map[string]struct{}{}
+
+	resp, err := http.Get(commandsIndex)
+	if err != nil {
+		die("failed to fetch external commands: %s", err)
+	}
+	defer resp.Body.Close()
+
+	t := html.NewTokenizer(resp.Body)
+	for {
+		tt := t.Next()
+		switch tt {
+		case html.ErrorToken:
+			goto stop
+
+		case html.StartTagToken:
+			id = 0
+
+			if tag, _ := t.TagName(); bytes.Equal(tag, []byte("a")) {
+				for {
+					key, val, moreAttr := t.TagAttr()
+					if bytes.Equal(key, []byte("href")) && bytes.HasPrefix(val, []byte(commandsURLPrefix)) {
+						id, err = strconv.Atoi(string(bytes.TrimPrefix(val, []byte(commandsURLPrefix))))
+						if err != nil {
+							die("failed to parse integer: %s", err)
+						}
+					} else if !moreAttr {
+						break
+					}
+				}
+			}
+
+		case html.EndTagToken:
+			id = 0
+
+		case html.TextToken:
+			if id > 0 {
+				name := string(t.Text())
+
+				if _, ok := commandsMap[name]; ok {
+					fmt.Fprintf(os.Stderr, "Warning: skipping already defined %q command\n", name)
+					continue
+				}
+
+				commands = append(commands, &command{
+					ID:   id,
+					Name: name,
+				})
+
+				commandsMap[name] = struct{}{}
+			}
+		}
+	}
+stop:
+
+	for _, cmd := range commands {
+		var (
+			formatFound bool
+			descFound   bool
+		)
+
+		resp, err := http.Get(fmt.Sprintf("%s%s%d", commandsIndex, commandsURLPrefix, cmd.ID))
+		if err != nil {
+			die("failed to fetch external commands: %s", err)
+		}
+		defer resp.Body.Close()
+
+		t := html.NewTokenizer(resp.Body)
+		for {
+			tt := t.Next()
+			switch tt {
+			case html.ErrorToken:
+				goto next
+
+			case html.TextToken:
+				text := strings.TrimSpace(string(t.Text()))
+
+				if len(text) == 0 {
+					continue
+				} else if text == "Command Format:" {
+					formatFound = true
+				} else if text == "Description:" {
+					descFound = true
+				} else if formatFound {
+					for _, m := range commandArgRegexp.FindAllStringSubmatch(text, -1) {
+						// FIXME: there are typos and duplicates for both "CHANGE_HOST_CHECK_TIMEPERIOD" and
+						// "SCHEDULE_SVC_DOWNTIME", see:
+						//  * https://old.nagios.org/developerinfo/externalcommands/commandinfo.php?command_id=119
+						//  * https://old.nagios.org/developerinfo/externalcommands/commandinfo.php?command_id=133
+						//  * https://old.nagios.org/developerinfo/externalcommands/commandinfo.php?command_id=138
+						if m[1] == "check_timeperod" {
+							m[1] = "check_timeperiod"
+						} else if m[1] == "service_desription" {
+							m[1] = "service_description"
+						}
+
+						typ, ok := commandTypes[m[1]]
+						if !ok {
+							fmt.Fprintf(os.Stderr, "Warning: unknown %q argument type, defaulting to string\n", m[1])
+							typ = spec.TypeString
+						}
+
+						cmd.Args = append(cmd.Args, &spec.Arg{
+							Name:     m[1],
+							Type:     typ,
+							Unit:     commandUnits[m[1]],
+							Required: requiredArgs[m[1]],
+						})
+					}
+					formatFound = false
+				} else if descFound {
+					// Paragraphs are separated by double spaces in the page text
+					for _, p := range strings.Split(text, "  ") {
+						if p = strings.Join(strings.Fields(p), " "); p != "" {
+							cmd.Description = append(cmd.Description, p)
+						}
+					}
+					descFound = false
+				}
+			}
+		}
+	next:
+	}
+
+	return commands
+}
+
+func die(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "Error: "+format+"\n", args...)
+	os.Exit(1)
+}
+
+type command struct {
+	ID          int
+	Name        string
+	Description []string
+	Args        []*spec.Arg
+}
@@ -0,0 +1,151 @@
+// Package spec defines the structured specification of the monitoring core external commands, used to generate
+// the commands packages.
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Argument types
+const (
+	TypeAckSticky           = "ack_sticky"
+	TypeBool                = "bool"
+	TypeDuration            = "duration"
+	TypeHostState           = "host_state"
+	TypeInt                 = "int"
+	TypeNotificationOptions = "notification_options"
+	TypeServiceState        = "service_state"
+	TypeString              = "string"
+	TypeTime                = "time"
+)
+
+// Argument units
+const (
+	UnitSeconds   = "seconds"
+	UnitIntervals = "intervals"
+	UnitTimestamp = "timestamp"
+)
+
+// Spec represents a commands specification file.
+type Spec struct {
+	// Package is the name of the generated Go package.
+	Package string `json:"package"`
+	// Core is the name of the monitoring core used in the generated documentation.
+	Core string `json:"core"`
+	// Supported lists the monitoring cores accepting the commands.
+	Supported []string `json:"supported"`
+	// Notes contains additional support notes, rendered in the support documentation.
+	Notes []string `json:"notes,omitempty"`
+
+	Commands []*Command `json:"commands"`
+}
+
+// Command represents an external command specification.
+type Command struct {
+	Name        string   `json:"name"`
+	Description []string `json:"description"`
+	Args        []*Arg   `json:"args,omitempty"`
+
+	// Supported overrides the monitoring cores accepting the command.
+	Supported []string `json:"supported,omitempty"`
+}
+
+// SupportedBy returns the monitoring cores accepting a given command.
+func (s *Spec) SupportedBy(cmd *Command) []string {
+	if len(cmd.Supported) > 0 {
+		return cmd.Supported
+	}
+
+	return s.Supported
+}
+
+// Arg represents an external command argument specification.
+type Arg struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Unit     string `json:"unit,omitempty"`
+	Required bool   `json:"required,omitempty"`
+	// Optional arguments are left out of the command when having their zero value. Only the last argument can be
+	// optional.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// FuncName returns the name of the Go function creating a given command (e.g. `AcknowledgeHostProblem` for the
+// `ACKNOWLEDGE_HOST_PROBLEM` command).
+func FuncName(name string) string {
+	parts := []string{}
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			parts = append(parts, part[:1]+strings.ToLower(part[1:]))
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// Load reads a commands specification from a given file.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Spec{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+
+	return s, s.Validate()
+}
+
+// Save writes a commands specification to a given file.
+func (s *Spec) Save(path string) error {
+	buf := bytes.NewBuffer(nil)
+
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// Validate checks the specification consistency.
+func (s *Spec) Validate() error {
+	if s.Package == "" {
+		return fmt.Errorf("missing package name")
+	}
+
+	names := map[string]struct{}{}
+
+	for _, cmd := range s.Commands {
+		if cmd.Name == "" {
+			return fmt.Errorf("command with empty name")
+		} else if _, ok := names[cmd.Name]; ok {
+			return fmt.Errorf("duplicate %q command", cmd.Name)
+		}
+		names[cmd.Name] = struct{}{}
+
+		for i, arg := range cmd.Args {
+			if arg.Optional && (arg.Required || i != len(cmd.Args)-1) {
+				return fmt.Errorf("invalid optional %q argument of %q command", arg.Name, cmd.Name)
+			}
+
+			switch arg.Type {
+			case TypeAckSticky, TypeBool, TypeDuration, TypeHostState, TypeInt, TypeNotificationOptions,
+				TypeServiceState, TypeString, TypeTime:
+			default:
+				return fmt.Errorf("invalid type %q for %q argument of %q command", arg.Type, arg.Name, cmd.Name)
+			}
+		}
+	}
+
+	return nil
+}
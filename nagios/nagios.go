@@ -1,32 +1,7 @@
 package nagios
 
-import (
-	"fmt"
-	"time"
-)
-
-//go:generate go run internal/generate-commands/main.go -o commands.go
-
-func stringifyArg(name, typ string, v interface{}) string {
-	switch typ {
-	case "bool":
-		if !v.(bool) {
-			return "0"
-		} else if name == "sticky" {
-			return "2"
-		}
-
-		return "1"
-
-	case "int", "interface{}", "string":
-		return fmt.Sprintf("%v", v)
-
-	case "time.Duration":
-		return fmt.Sprintf("%d", v.(time.Duration)/time.Second)
-
-	case "time.Time":
-		return fmt.Sprintf("%d", v.(time.Time).Unix())
-	}
-
-	return ""
-}
+// Commands are generated from the commands.json specification file, initially imported from the Nagios developer
+// website using internal/import-commands.
+//
+//go:generate go run internal/generate-commands/main.go -spec commands.json -o commands.go -test commands_test.go
+//go:generate go run internal/generate-support/main.go -o ../SUPPORT.md commands.json ../naemon/commands.json ../icinga/commands.json ../checkmk/commands.json
@@ -0,0 +1,132 @@
+package nagios
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AckSticky represents the stickiness of a problem acknowledgement.
+type AckSticky int
+
+// Acknowledgement stickiness values
+const (
+	// AckNotSticky acknowledgements are removed as soon as the object changes state.
+	AckNotSticky AckSticky = 0
+	// AckStickyUntilOK acknowledgements remain until the object returns to an UP or OK state.
+	AckStickyUntilOK AckSticky = 2
+)
+
+func (s AckSticky) String() string {
+	switch s {
+	case 0, 1:
+		return "not sticky"
+	case AckStickyUntilOK:
+		return "sticky"
+	}
+
+	return fmt.Sprintf("AckSticky(%d)", int(s))
+}
+
+// NotificationOptions represents the options bitmask of custom notifications.
+type NotificationOptions int
+
+// Custom notifications options
+const (
+	// NotificationBroadcast sends the notification to all normal and escalated contacts.
+	NotificationBroadcast NotificationOptions = 1 << iota
+	// NotificationForced sends the notification regardless of the time of day and of notifications being enabled.
+	NotificationForced
+	// NotificationIncrement increments the object current notification number.
+	NotificationIncrement
+)
+
+var notificationOptionsNames = []string{"broadcast", "forced", "increment"}
+
+func (o NotificationOptions) String() string {
+	if o == 0 {
+		return "none"
+	}
+
+	names := []string{}
+	for i, name := range notificationOptionsNames {
+		if o&(1<<i) != 0 {
+			names = append(names, name)
+			o &^= 1 << i
+		}
+	}
+
+	if o != 0 {
+		names = append(names, fmt.Sprintf("0x%x", int(o)))
+	}
+
+	return strings.Join(names, "|")
+}
+
+// HostState represents the state of a host passive check result.
+type HostState int
+
+// Host states
+const (
+	HostUp HostState = iota
+	HostDown
+	HostUnreachable
+)
+
+var hostStateNames = []string{"UP", "DOWN", "UNREACHABLE"}
+
+func (s HostState) String() string {
+	if s >= 0 && int(s) < len(hostStateNames) {
+		return hostStateNames[s]
+	}
+
+	return fmt.Sprintf("HostState(%d)", int(s))
+}
+
+// ServiceState represents the state of a service passive check result.
+type ServiceState int
+
+// Service states
+const (
+	ServiceOK ServiceState = iota
+	ServiceWarning
+	ServiceCritical
+	ServiceUnknown
+)
+
+var serviceStateNames = []string{"OK", "WARNING", "CRITICAL", "UNKNOWN"}
+
+func (s ServiceState) String() string {
+	if s >= 0 && int(s) < len(serviceStateNames) {
+		return serviceStateNames[s]
+	}
+
+	return fmt.Sprintf("ServiceState(%d)", int(s))
+}
+
+// DefaultIntervalLength is the default number of seconds per check interval unit (the `interval_length` setting of
+// the monitoring core).
+const DefaultIntervalLength = 60 * time.Second
+
+// Intervals represents a duration expressed in check interval units, as expected by the check intervals commands.
+type Intervals float64
+
+// ToIntervals converts a duration into check interval units of a given length, DefaultIntervalLength being used if
+// length is 0 (e.g. `ToIntervals(5*time.Minute, 0)` returns 5 intervals).
+func ToIntervals(d, length time.Duration) Intervals {
+	if length <= 0 {
+		length = DefaultIntervalLength
+	}
+
+	return Intervals(float64(d) / float64(length))
+}
+
+// Duration returns the duration of the intervals for a given interval length, DefaultIntervalLength being used if
+// length is 0.
+func (i Intervals) Duration(length time.Duration) time.Duration {
+	if length <= 0 {
+		length = DefaultIntervalLength
+	}
+
+	return time.Duration(float64(i) * float64(length))
+}
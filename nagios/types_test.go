@@ -0,0 +1,89 @@
+package nagios
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+func Test_TypesString(t *testing.T) {
+	for _, test := range []struct {
+		value    fmt.Stringer
+		expected string
+	}{
+		{AckNotSticky, "not sticky"},
+		{AckSticky(1), "not sticky"},
+		{AckStickyUntilOK, "sticky"},
+		{AckSticky(3), "AckSticky(3)"},
+		{NotificationOptions(0), "none"},
+		{NotificationBroadcast | NotificationIncrement, "broadcast|increment"},
+		{NotificationForced | NotificationOptions(8), "forced|0x8"},
+		{HostUnreachable, "UNREACHABLE"},
+		{HostState(3), "HostState(3)"},
+		{ServiceCritical, "CRITICAL"},
+		{ServiceState(-1), "ServiceState(-1)"},
+	} {
+		if result := test.value.String(); result != test.expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		}
+	}
+}
+
+func Test_TypesValues(t *testing.T) {
+	for _, test := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{AckStickyUntilOK, "2"},
+		{NotificationBroadcast | NotificationForced, "3"},
+		{HostUnreachable, "2"},
+		{ServiceCritical, "2"},
+		{Intervals(2.5), "2.5"},
+	} {
+		cmd := livestatus.NewCommand("COMMAND1").Arg(test.value).Timestamp(time.Unix(1439633040, 0))
+		if err := cmd.Err(); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if result := cmd.String(); result != "COMMAND [1439633040] COMMAND1;"+test.expected+"\n\n" {
+			t.Logf("\nExpected %q\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		}
+
+		q := livestatus.NewQuery("table1").FilterValue("column1", "=", test.value)
+		if err := q.Err(); err != nil {
+			t.Logf("\nExpected no error\nbut got  %#v\n", err)
+			t.Fail()
+		} else if result := q.String(); !strings.Contains(result, "\nFilter: column1 = "+test.expected+"\n") {
+			t.Logf("\nExpected %q filter\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		}
+	}
+}
+
+func Test_Intervals(t *testing.T) {
+	if result := ToIntervals(90*time.Second, 0); result != 1.5 {
+		t.Logf("\nExpected %v\nbut got  %v\n", 1.5, result)
+		t.Fail()
+	}
+
+	if result := ToIntervals(5*time.Minute, 30*time.Second); result != 10 {
+		t.Logf("\nExpected %v\nbut got  %v\n", 10, result)
+		t.Fail()
+	}
+
+	if result := Intervals(2.5).Duration(0); result != 150*time.Second {
+		t.Logf("\nExpected %s\nbut got  %s\n", 150*time.Second, result)
+		t.Fail()
+	}
+
+	expected := "COMMAND [1439633040] CHANGE_NORMAL_HOST_CHECK_INTERVAL;host1;1.5\n\n"
+	if result := ChangeNormalHostCheckInterval("host1", ToIntervals(90*time.Second, 0)).
+		Timestamp(time.Unix(1439633040, 0)).String(); result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
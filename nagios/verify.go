@@ -0,0 +1,354 @@
+package nagios
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+const (
+	// DefaultVerifyTimeout is the default maximum duration to wait for a command effect to appear.
+	DefaultVerifyTimeout = 10 * time.Second
+	// DefaultVerifyInterval is the default interval between two checks of a command effect.
+	DefaultVerifyInterval = 500 * time.Millisecond
+)
+
+// ErrNotVerifiable represents a command whose effect can't be checked against the Livestatus state.
+var ErrNotVerifiable = errors.New("command not verifiable")
+
+// Verifier represents a commands verifier, sending commands and polling the Livestatus tables until their effect
+// appears (e.g. the acknowledgement comment being added after an ACKNOWLEDGE_SVC_PROBLEM command).
+//
+// Effects matching the state observed before sending the command are ignored, thus acknowledging an already
+// acknowledged problem is only verified once the new acknowledgement comment appears, and passive check results
+// once the last check time changes.
+//
+// The following commands are verified: ACKNOWLEDGE_HOST_PROBLEM, ACKNOWLEDGE_SVC_PROBLEM, their `_EXPIRE`
+// variants, REMOVE_HOST_ACKNOWLEDGEMENT, REMOVE_SVC_ACKNOWLEDGEMENT, SCHEDULE_HOST_DOWNTIME, SCHEDULE_SVC_DOWNTIME,
+// DEL_HOST_DOWNTIME, DEL_SVC_DOWNTIME, ADD_HOST_COMMENT, ADD_SVC_COMMENT, DEL_HOST_COMMENT, DEL_SVC_COMMENT,
+// PROCESS_HOST_CHECK_RESULT and PROCESS_SERVICE_CHECK_RESULT.
+type Verifier struct {
+	client   *livestatus.Client
+	catalog  Catalog
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// NewVerifier creates a new commands verifier using a given client.
+func NewVerifier(c *livestatus.Client) *Verifier {
+	return &Verifier{
+		client:   c,
+		catalog:  Commands,
+		timeout:  DefaultVerifyTimeout,
+		interval: DefaultVerifyInterval,
+	}
+}
+
+// Catalog sets the catalog used to read the commands arguments, allowing to verify commands specific to other
+// monitoring cores (e.g. `nagios.Commands.Merge(icinga.Commands)`).
+func (v *Verifier) Catalog(c Catalog) *Verifier {
+	v.catalog = c
+	return v
+}
+
+// Timeout sets the maximum duration to wait for a command effect to appear.
+func (v *Verifier) Timeout(d time.Duration) *Verifier {
+	v.timeout = d
+	return v
+}
+
+// Interval sets the interval between two checks of a command effect.
+func (v *Verifier) Interval(d time.Duration) *Verifier {
+	v.interval = d
+	return v
+}
+
+// Exec sends a given command and waits for its effect to appear, returning a VerifyError holding the observed
+// state if it doesn't before the timeout expires or the context is cancelled. Commands not supported by the
+// verifier are not sent, an ErrNotVerifiable error being returned instead.
+//
+// Commands not explicitly timestamped are stamped using the client clock. Effects are looked for since the command
+// timestamp, thus the clocks of the client and of the monitoring core must be synchronized.
+func (v *Verifier) Exec(ctx context.Context, cmd *livestatus.Command) error {
+	if err := cmd.Err(); err != nil {
+		return err
+	}
+
+	// Stamp the command once, ensuring the expectations use the timestamp actually sent
+	cmd = cmd.Stamped(v.client.Clock().Now())
+
+	pc, err := v.catalog.Parse(cmd.String())
+	if err != nil {
+		return err
+	}
+
+	fn, ok := verifications[pc.Name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotVerifiable, pc.Name)
+	}
+	e := fn(pc)
+
+	if e.key != "" {
+		// Exclude the records already matching the expected effect before sending the command
+		resp, err := v.client.Exec(e.query(true))
+		if err != nil {
+			return err
+		}
+
+		for _, r := range resp.Records {
+			e.effects = append(e.effects, filter{e.key, "!=", fmt.Sprint(r[e.key])})
+		}
+	}
+
+	if _, err := v.client.Exec(cmd); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	for {
+		resp, err := v.client.Exec(e.query(true))
+		if err != nil {
+			return err
+		} else if (len(resp.Records) > 0) != e.absent {
+			return nil
+		}
+
+		if !sleepContext(ctx, v.interval) {
+			break
+		}
+	}
+
+	verr := VerifyError{
+		Command:  pc.Name,
+		Table:    e.table,
+		Expected: e.String(),
+		Err:      ctx.Err(),
+	}
+
+	if resp, err := v.client.Exec(e.query(false)); err == nil {
+		verr.Observed = resp.Records
+	}
+
+	return verr
+}
+
+// VerifyError represents a command whose effect didn't appear in time.
+type VerifyError struct {
+	Command string
+	Table   string
+	// Expected describes the expected effect.
+	Expected string
+	// Observed contains the records of the objects targeted by the command, as observed after the timeout
+	// expiration.
+	Observed []livestatus.Record
+
+	Err error
+}
+
+func (e VerifyError) Error() string {
+	return fmt.Sprintf("failed to verify %s command: expected %s in %s table but observed %v: %v", e.Command,
+		e.Expected, e.Table, e.Observed, e.Err)
+}
+
+// Unwrap returns the underlying context error.
+func (e VerifyError) Unwrap() error {
+	return e.Err
+}
+
+type filter struct {
+	column   string
+	operator string
+	value    string
+}
+
+func (f filter) String() string {
+	return fmt.Sprintf("%s %s %s", f.column, f.operator, f.value)
+}
+
+// expectation represents the expected effect of a command on a Livestatus table.
+type expectation struct {
+	table   string
+	columns []string
+	// objects selects the objects targeted by the command, effects the expected state of these objects.
+	objects []filter
+	effects []filter
+	// absent indicates that the command removes the selected objects.
+	absent bool
+	// key is the column whose value has to change for the effect to be considered as caused by the command, the
+	// records matching the effect before sending the command being excluded using their key value.
+	key string
+}
+
+func (e expectation) query(withEffects bool) *livestatus.Query {
+	q := livestatus.NewQuery(e.table).Columns(e.columns...)
+
+	filters := e.objects
+	if withEffects {
+		filters = append(append([]filter{}, e.objects...), e.effects...)
+	}
+
+	for _, f := range filters {
+		q.FilterValue(f.column, f.operator, f.value)
+	}
+
+	return q
+}
+
+func (e expectation) String() string {
+	if e.absent {
+		return "no record matching " + joinFilters(e.objects)
+	}
+
+	return "a record matching " + joinFilters(append(append([]filter{}, e.objects...), e.effects...))
+}
+
+func joinFilters(filters []filter) string {
+	parts := make([]string, len(filters))
+	for i, f := range filters {
+		parts[i] = f.String()
+	}
+
+	return strings.Join(parts, " and ")
+}
+
+var verifications = map[string]func(*ParsedCommand) expectation{
+	"ACKNOWLEDGE_HOST_PROBLEM":        verifyAck,
+	"ACKNOWLEDGE_HOST_PROBLEM_EXPIRE": verifyAck,
+	"ACKNOWLEDGE_SVC_PROBLEM":         verifyAck,
+	"ACKNOWLEDGE_SVC_PROBLEM_EXPIRE":  verifyAck,
+	"REMOVE_HOST_ACKNOWLEDGEMENT":     verifyAckRemoved,
+	"REMOVE_SVC_ACKNOWLEDGEMENT":      verifyAckRemoved,
+	"SCHEDULE_HOST_DOWNTIME":          verifyEntry("downtimes", "start_time", "end_time"),
+	"SCHEDULE_SVC_DOWNTIME":           verifyEntry("downtimes", "start_time", "end_time"),
+	"DEL_HOST_DOWNTIME":               verifyDeleted("downtimes", "downtime_id"),
+	"DEL_SVC_DOWNTIME":                verifyDeleted("downtimes", "downtime_id"),
+	"ADD_HOST_COMMENT":                verifyEntry("comments"),
+	"ADD_SVC_COMMENT":                 verifyEntry("comments"),
+	"DEL_HOST_COMMENT":                verifyDeleted("comments", "comment_id"),
+	"DEL_SVC_COMMENT":                 verifyDeleted("comments", "comment_id"),
+	"PROCESS_HOST_CHECK_RESULT":       verifyCheckResult,
+	"PROCESS_SERVICE_CHECK_RESULT":    verifyCheckResult,
+}
+
+// rawArg returns the text of a given command argument.
+func rawArg(pc *ParsedCommand, name string) (string, bool) {
+	for _, a := range pc.Args {
+		if a.Name == name {
+			return a.Raw, true
+		}
+	}
+
+	return "", false
+}
+
+// objectFilters returns the filters selecting the host or service targeted by a command, either in the hosts and
+// services tables or in the tables referencing them (e.g. downtimes).
+func objectFilters(pc *ParsedCommand, referenced bool) []filter {
+	host, _ := rawArg(pc, "host_name")
+	svc, isService := rawArg(pc, "service_description")
+
+	switch {
+	case referenced && isService:
+		return []filter{{"host_name", "=", host}, {"service_description", "=", svc}}
+	case referenced:
+		return []filter{{"host_name", "=", host}, {"is_service", "=", "0"}}
+	case isService:
+		return []filter{{"host_name", "=", host}, {"description", "=", svc}}
+	}
+
+	return []filter{{"name", "=", host}}
+}
+
+func objectTable(pc *ParsedCommand) string {
+	if _, ok := rawArg(pc, "service_description"); ok {
+		return "services"
+	}
+
+	return "hosts"
+}
+
+// commentAcknowledgement is the `entry_type` of the acknowledgements comments.
+const commentAcknowledgement = "4"
+
+// verifyAck checks for a new acknowledgement comment, the `acknowledged` flag possibly being already set before
+// the command is sent.
+func verifyAck(pc *ParsedCommand) expectation {
+	e := verifyEntry("comments")(pc)
+	e.columns = append(e.columns, "entry_type")
+	e.effects = append(e.effects, filter{"entry_type", "=", commentAcknowledgement})
+
+	return e
+}
+
+func verifyAckRemoved(pc *ParsedCommand) expectation {
+	return expectation{
+		table:   objectTable(pc),
+		columns: []string{"state", "acknowledged"},
+		objects: objectFilters(pc, false),
+		effects: []filter{{"acknowledged", "=", "0"}},
+	}
+}
+
+// verifyEntry checks for a new downtime or comment entry, matching its author, comment and the given additional
+// arguments.
+func verifyEntry(table string, args ...string) func(*ParsedCommand) expectation {
+	return func(pc *ParsedCommand) expectation {
+		e := expectation{
+			table:   table,
+			columns: append([]string{"id", "author", "comment", "entry_time"}, args...),
+			objects: objectFilters(pc, true),
+			effects: []filter{{"entry_time", ">=", strconv.FormatInt(pc.Timestamp.Unix(), 10)}},
+			key:     "id",
+		}
+
+		for _, name := range append([]string{"author", "comment"}, args...) {
+			v, _ := rawArg(pc, name)
+			e.effects = append(e.effects, filter{name, "=", v})
+		}
+
+		return e
+	}
+}
+
+func verifyDeleted(table, arg string) func(*ParsedCommand) expectation {
+	return func(pc *ParsedCommand) expectation {
+		id, _ := rawArg(pc, arg)
+
+		return expectation{
+			table:   table,
+			columns: []string{"id"},
+			objects: []filter{{"id", "=", id}},
+			absent:  true,
+		}
+	}
+}
+
+func verifyCheckResult(pc *ParsedCommand) expectation {
+	// Passive check results are stamped with the command timestamp, a later check possibly having already run
+	return expectation{
+		table:   objectTable(pc),
+		columns: []string{"state", "plugin_output", "last_check"},
+		objects: objectFilters(pc, false),
+		effects: []filter{{"last_check", ">=", strconv.FormatInt(pc.Timestamp.Unix(), 10)}},
+		key:     "last_check",
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
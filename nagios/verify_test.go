@@ -0,0 +1,251 @@
+package nagios
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	livestatus "github.com/vbatoufflet/go-livestatus"
+)
+
+func Test_VerifierExec(t *testing.T) {
+	core := newTestCore(`[]`, `[]`, `[[2,"author1","comment1",1439633040,4]]`)
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	cmd := AcknowledgeSvcProblem("host1", "svc1", AckStickyUntilOK, true, false, "author1", "comment1")
+
+	err := NewVerifier(c).Interval(time.Millisecond).Exec(context.Background(), cmd)
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if len(core.requests) != 4 {
+		t.Fatalf("\nExpected 4 requests\nbut got  %d\n", len(core.requests))
+	}
+
+	if !strings.HasPrefix(core.requests[1], "COMMAND ") {
+		t.Logf("\nExpected command\nbut got  %q\n", core.requests[1])
+		t.Fail()
+	}
+
+	expected := "GET comments\nColumns: id author comment entry_time entry_type\nFilter: host_name = host1\n" +
+		"Filter: service_description = svc1\n"
+	for _, i := range []int{0, 2, 3} {
+		req := core.requests[i]
+		if !strings.HasPrefix(req, expected) || !strings.Contains(req, "\nFilter: entry_type = 4\n") {
+			t.Logf("\nExpected %q\nbut got  %q\n", expected, req)
+			t.Fail()
+		}
+	}
+}
+
+func Test_VerifierExecAcknowledged(t *testing.T) {
+	// The problem is already acknowledged, the existing acknowledgement comment having to be ignored
+	core := &testCore{answer: func(req string, commands []string) string {
+		switch {
+		case !strings.Contains(req, "Filter: id != 1\n"):
+			return `[[1,"author1","comment1",1439633040,4]]`
+		case len(commands) > 0:
+			return `[[2,"author1","comment1",1439633040,4]]`
+		}
+
+		return `[]`
+	}}
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633040, 0)})
+	defer c.Close()
+
+	cmd := AcknowledgeHostProblem("host1", AckStickyUntilOK, true, false, "author1", "comment1")
+
+	if err := NewVerifier(c).Interval(time.Millisecond).Exec(context.Background(), cmd); err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.Fail()
+	}
+
+	// The command being ignored by the core, its effect must not be verified
+	core.mu.Lock()
+	core.answer = func(req string, commands []string) string {
+		if strings.Contains(req, "Filter: id != 1\n") {
+			return `[]`
+		}
+		return `[[1,"author1","comment1",1439633040,4]]`
+	}
+	core.mu.Unlock()
+
+	err := NewVerifier(c).Interval(time.Millisecond).Timeout(20*time.Millisecond).Exec(context.Background(), cmd)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", context.DeadlineExceeded, err)
+		t.Fail()
+	}
+}
+
+func Test_VerifierExecClock(t *testing.T) {
+	core := newTestCore(`[]`, `[[1,"output1",1439633040]]`)
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633040, 0)})
+	defer c.Close()
+
+	err := NewVerifier(c).Interval(time.Millisecond).Exec(context.Background(),
+		ProcessHostCheckResult("host1", HostDown, "output1"))
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	if len(core.requests) != 3 {
+		t.Fatalf("\nExpected 3 requests\nbut got  %d\n", len(core.requests))
+	}
+
+	expected := "COMMAND [1439633040] PROCESS_HOST_CHECK_RESULT;host1;1;output1\n\n"
+	if core.requests[1] != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, core.requests[1])
+		t.Fail()
+	}
+
+	if !strings.Contains(core.requests[2], "\nFilter: last_check >= 1439633040\n") {
+		t.Logf("\nExpected last_check filter\nbut got  %q\n", core.requests[2])
+		t.Fail()
+	}
+}
+
+func Test_VerifierExecDeleted(t *testing.T) {
+	core := newTestCore(`[[42]]`, `[]`)
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	err := NewVerifier(c).Interval(time.Millisecond).Exec(context.Background(), DelHostDowntime(42))
+	if err != nil {
+		t.Logf("\nExpected no error\nbut got  %#v\n", err)
+		t.FailNow()
+	}
+
+	expected := "GET downtimes\nColumns: id\nFilter: id = 42\n"
+	if len(core.requests) != 3 || !strings.HasPrefix(core.requests[2], expected) {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, core.requests)
+		t.Fail()
+	}
+}
+
+func Test_VerifierExecTimeout(t *testing.T) {
+	core := newTestCore(`[]`)
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		if strings.Contains(req, "Filter: last_check") {
+			return core.handle(req)
+		}
+		return 200, `[[0,"output1",1439633000]]`
+	})
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	cmd := ProcessHostCheckResult("host1", HostDown, "output2").Timestamp(time.Unix(1439633040, 0))
+
+	err := NewVerifier(c).Interval(time.Millisecond).Timeout(20*time.Millisecond).Exec(context.Background(), cmd)
+
+	verr, ok := err.(VerifyError)
+	if !ok {
+		t.Fatalf("\nExpected VerifyError\nbut got  %#v\n", err)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", context.DeadlineExceeded, verr.Err)
+		t.Fail()
+	}
+
+	expected := "a record matching name = host1 and last_check >= 1439633040"
+	if verr.Command != "PROCESS_HOST_CHECK_RESULT" || verr.Table != "hosts" || verr.Expected != expected {
+		t.Logf("\nUnexpected error %#v\n", verr)
+		t.Fail()
+	}
+
+	if len(verr.Observed) != 1 || verr.Observed[0]["last_check"] != int64(1439633000) {
+		t.Logf("\nUnexpected observed state %#v\n", verr.Observed)
+		t.Fail()
+	}
+}
+
+func Test_VerifierExecNotVerifiable(t *testing.T) {
+	core := newTestCore(`[]`)
+
+	addr, stop := newTestServer(t, core.handle)
+	defer stop()
+
+	c := livestatus.NewClient("tcp", addr)
+	defer c.Close()
+
+	err := NewVerifier(c).Exec(context.Background(), EnableNotifications())
+	if !errors.Is(err, ErrNotVerifiable) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrNotVerifiable, err)
+		t.Fail()
+	}
+
+	if len(core.requests) != 0 {
+		t.Logf("\nExpected no request\nbut got  %q\n", core.requests)
+		t.Fail()
+	}
+}
+
+func Test_VerifierExpectations(t *testing.T) {
+	for _, test := range []struct {
+		cmd      *livestatus.Command
+		expected string
+	}{
+		{
+			ScheduleHostDowntime("host1", time.Unix(1439633040, 0), time.Unix(1439636640, 0), true, 0, time.Hour,
+				"author1", "comment1").Timestamp(time.Unix(1439633000, 0)),
+			"a record matching host_name = host1 and is_service = 0 and entry_time >= 1439633000 and " +
+				"author = author1 and comment = comment1 and start_time = 1439633040 and end_time = 1439636640",
+		},
+		{
+			AddSvcComment("host1", "svc1", true, "author1", "comment1").Timestamp(time.Unix(1439633000, 0)),
+			"a record matching host_name = host1 and service_description = svc1 and entry_time >= 1439633000 and " +
+				"author = author1 and comment = comment1",
+		},
+		{
+			AcknowledgeHostProblem("host1", AckStickyUntilOK, true, false, "author1", "comment1").
+				Timestamp(time.Unix(1439633000, 0)),
+			"a record matching host_name = host1 and is_service = 0 and entry_time >= 1439633000 and " +
+				"author = author1 and comment = comment1 and entry_type = 4",
+		},
+		{
+			RemoveHostAcknowledgement("host1"),
+			"a record matching name = host1 and acknowledged = 0",
+		},
+		{
+			DelSvcComment(12),
+			"no record matching id = 12",
+		},
+	} {
+		pc, err := Commands.Parse(test.cmd.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result := verifications[pc.Name](pc).String(); result != test.expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", test.expected, result)
+			t.Fail()
+		}
+	}
+}
@@ -11,12 +11,32 @@ import (
 	"time"
 )
 
+var filterOperators = map[string]struct{}{
+	"=":   {},
+	"!=":  {},
+	"~":   {},
+	"!~":  {},
+	"=~":  {},
+	"!=~": {},
+	"~~":  {},
+	"!~~": {},
+	"<":   {},
+	">":   {},
+	"<=":  {},
+	">=":  {},
+}
+
 // Query represents a Livestatus query instance.
 type Query struct {
 	table     string
 	headers   []string
 	columns   []string
+	limit     int
 	keepalive bool
+	localtime bool
+	strict    bool
+	params    []queryParam
+	err       error
 
 	writeTimeout time.Duration
 	readTimeout  time.Duration
@@ -44,6 +64,28 @@ func (q *Query) Filter(rule string) *Query {
 	return q
 }
 
+// FilterValue appends a new filter to the query, comparing a column with a value using a given operator.
+//
+// The value is rendered using its Livestatus representation (e.g. booleans as 0/1 and time values as UNIX
+// timestamps) and is rejected if it contains characters able to alter the query, in which case the error is
+// returned by Err and when executing the query.
+func (q *Query) FilterValue(column, operator string, value interface{}) *Query {
+	rule, err := formatRule("Filter", column, operator, value)
+	if err != nil {
+		q.setErr(err)
+		return q
+	}
+
+	q.headers = append(q.headers, "Filter: "+rule)
+	return q
+}
+
+// FilterParam appends a new filter to the query, comparing a column with the value bound to a named placeholder.
+// The query must be turned into a QueryTemplate for the placeholder to be bound before executing it.
+func (q *Query) FilterParam(column, operator, name string) *Query {
+	return q.appendParam("Filter", column, operator, name)
+}
+
 // And combines the n last filters into a new filter using a `And` operation.
 func (q *Query) And(n int) *Query {
 	q.headers = append(q.headers, fmt.Sprintf("And: %d", n))
@@ -65,6 +107,7 @@ func (q *Query) Negate() *Query {
 // Limit sets the limit of datasets to retrieve.
 func (q *Query) Limit(n int) *Query {
 	q.headers = append(q.headers, fmt.Sprintf("Limit: %d", n))
+	q.limit = n
 	return q
 }
 
@@ -83,6 +126,25 @@ func (q *Query) WaitCondition(rule string) *Query {
 	return q
 }
 
+// WaitConditionValue appends a new wait condition to the query, comparing a column with a value using a given
+// operator. The value is checked and rendered the same way as with FilterValue.
+func (q *Query) WaitConditionValue(column, operator string, value interface{}) *Query {
+	rule, err := formatRule("WaitCondition", column, operator, value)
+	if err != nil {
+		q.setErr(err)
+		return q
+	}
+
+	q.headers = append(q.headers, "WaitCondition: "+rule)
+	return q
+}
+
+// WaitConditionParam appends a new wait condition to the query, comparing a column with the value bound to a
+// named placeholder. See FilterParam for details.
+func (q *Query) WaitConditionParam(column, operator, name string) *Query {
+	return q.appendParam("WaitCondition", column, operator, name)
+}
+
 // WaitConditionAnd combines the n last wait conditions into a new wait condition using a `And` operation.
 func (q *Query) WaitConditionAnd(n int) *Query {
 	q.headers = append(q.headers, fmt.Sprintf("WaitConditionAnd: %d", n))
@@ -114,6 +176,19 @@ func (q *Query) WaitTimeout(d time.Duration) *Query {
 	return q
 }
 
+// AuthUser restricts the query results to the objects a given contact is authorized to see.
+func (q *Query) AuthUser(name string) *Query {
+	q.headers = append(q.headers, "AuthUser: "+name)
+	return q
+}
+
+// Localtime sends the client current time along with the query, allowing Livestatus to compensate for the
+// clocks difference in the returned timestamps.
+func (q *Query) Localtime() *Query {
+	q.localtime = true
+	return q
+}
+
 // KeepAlive keeps the connection open to reuse for additional requests.
 func (q *Query) KeepAlive() *Query {
 	q.headers = append(q.headers, "KeepAlive: on")
@@ -136,21 +211,110 @@ func (q *Query) ReadTimeout(timeout time.Duration) *Query {
 	return q
 }
 
+// Strict enables the checking of the table name and of raw header values (e.g. the ones passed to Filter or
+// WaitObject) for characters able to alter the query. The query fails to execute if one of them is considered
+// unsafe.
+func (q *Query) Strict() *Query {
+	q.strict = true
+	return q
+}
+
+// Clone returns a deep copy of the query, which can be modified without affecting the original one.
+func (q Query) Clone() *Query {
+	clone := q
+	clone.headers = append([]string{}, q.headers...)
+	clone.columns = append([]string{}, q.columns...)
+	clone.params = append([]queryParam{}, q.params...)
+
+	return &clone
+}
+
+// Err returns the first error encountered while building the query.
+func (q Query) Err() error {
+	if q.err != nil {
+		return q.err
+	} else if len(q.params) > 0 {
+		return fmt.Errorf("unbound query parameter %q", q.params[0].name)
+	} else if q.strict {
+		if err := checkValue("table", q.table); err != nil {
+			return err
+		} else if i := strings.IndexByte(q.table, ' '); i != -1 {
+			return UnsafeValueError{Field: "table", Value: q.table, Position: i}
+		}
+
+		for _, h := range q.headers {
+			field := h
+			if idx := strings.Index(h, ":"); idx != -1 {
+				field = h[:idx]
+			}
+
+			if err := checkValue(field, h); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // String returns a string representation of the Livestatus query.
 func (q Query) String() string {
+	return q.render(time.Now())
+}
+
+// render returns a string representation of the Livestatus query, as sent at a given time.
+func (q Query) render(now time.Time) string {
 	s := "GET " + q.table
 	if len(q.headers) > 0 {
 		s += "\n" + strings.Join(q.headers, "\n")
 	}
+	if q.localtime {
+		s += fmt.Sprintf("\nLocaltime: %d", now.Unix())
+	}
 	s += "\nResponseHeader: fixed16\nOutputFormat: json\n\n"
 
 	return s
 }
 
-func (q Query) handle(conn net.Conn) (*Response, error) {
-	var err error
+func (q Query) handle(conn net.Conn, clock Clock) (*Response, error) {
+	resp, body, err := q.roundTrip(conn, clock)
+	if err != nil || len(body) == 0 {
+		return resp, err
+	}
+
+	// Parse received data for records
+	resp.Columns, resp.Records, err = q.parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("parsing read data as records failed: %v", err)
+	}
+	resp.Truncated = q.limit > 0 && len(resp.Records) >= q.limit
+
+	return resp, nil
+}
+
+// roundTrip sends the query and reads the response status and body.
+func (q Query) roundTrip(conn net.Conn, clock Clock) (*Response, []byte, error) {
+	resp, body, err := q.open(conn, clock)
+	if err != nil {
+		return resp, nil, err
+	}
 
-	cmd := q.String()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading body (buffer size: %d) failed: %v", len(data), err)
+	}
+
+	return resp, data, nil
+}
+
+// open sends the query and reads the response status, returning a reader on the response body.
+func (q Query) open(conn net.Conn, clock Clock) (*Response, io.Reader, error) {
+	err := q.Err()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cmd := q.render(clock.Now())
 	lcmd := len(cmd)
 
 	if q.writeTimeout > 0 {
@@ -163,11 +327,11 @@ func (q Query) handle(conn net.Conn) (*Response, error) {
 	// Send query data
 	n, err := conn.Write([]byte(cmd))
 	if err != nil {
-		return nil, fmt.Errorf("sending query failed: %v", err)
+		return nil, nil, fmt.Errorf("sending query failed: %v", err)
 	}
 
 	if n != lcmd {
-		return nil, fmt.Errorf("incomplete write to livestatus. Wrote %d bytes while %d were to be written", n, lcmd)
+		return nil, nil, fmt.Errorf("incomplete write to livestatus. Wrote %d bytes while %d were to be written", n, lcmd)
 	}
 
 	if q.readTimeout > 0 {
@@ -180,15 +344,15 @@ func (q Query) handle(conn net.Conn) (*Response, error) {
 	// Read response header
 	data := make([]byte, 16)
 
-	_, err = conn.Read(data)
+	_, err = io.ReadFull(conn, data)
 	if err != nil {
-		return nil, fmt.Errorf("reading response header failed: %v", err)
+		return nil, nil, fmt.Errorf("reading response header failed: %v", err)
 	}
 
-	resp := &Response{}
+	resp := &Response{Columns: q.columns}
 	resp.Status, err = strconv.Atoi(string(data[:3]))
 	if err != nil {
-		return nil, ParseError{
+		return nil, nil, ParseError{
 			Message:    fmt.Sprintf("parsing response status from header failed: %v", err),
 			FailedData: data[:3],
 			Buffer:     data,
@@ -197,89 +361,163 @@ func (q Query) handle(conn net.Conn) (*Response, error) {
 
 	length, err := strconv.Atoi(string(bytes.TrimSpace(data[5:15])))
 	if err != nil {
-		return nil, ParseError{
+		return nil, nil, ParseError{
 			Message:    fmt.Sprintf("parsing response length from header failed: %v", err),
 			FailedData: bytes.TrimSpace(data[5:15]),
 			Buffer:     data,
 		}
 	}
-	remainder := length
-
-	// Receive response data
-	buf := bytes.NewBuffer(nil)
 
-	for {
-		data = make([]byte, remainder)
+	body := io.LimitReader(conn, int64(length))
 
-		n, err := conn.Read(data)
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, fmt.Errorf("reading body (buffer size: %d, remainder: %d) failed: %v", buf.Len(), remainder, err)
+	// Stop on invalid status
+	if resp.Status >= 400 {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading body (buffer size: %d, remainder: %d) failed: %v", len(data),
+				length-len(data), err)
 		}
 
-		buf.Write(bytes.TrimRight(data, "\x00"))
-
-		remainder -= n
-		if remainder <= 0 {
-			break
+		resp.Message = strings.TrimRight(string(data), "\n")
+		return resp, nil, ResponseError{
+			Status:   resp.Status,
+			Message:  resp.Message,
+			Request:  cmd,
+			Response: resp,
 		}
 	}
 
-	if buf.Len() == 0 {
-		return resp, nil
-	}
+	return resp, body, nil
+}
 
-	// Stop on invalid status
-	if resp.Status >= 400 {
-		resp.Message = strings.TrimRight(buf.String(), "\n")
-		return resp, ErrInvalidQuery
-	}
+func (q Query) keepAlive() bool {
+	return q.keepalive
+}
 
-	// Parse received data for records
-	resp.Records, err = q.parse(buf.Bytes())
-	if err != nil {
-		return nil, fmt.Errorf("parsing read data as records failed: %v", err)
+func (q *Query) appendParam(field, column, operator, name string) *Query {
+	// Check rule using a dummy value, the actual one being checked while binding
+	if _, err := formatRule(field, column, operator, ""); err != nil {
+		q.setErr(err)
+		return q
 	}
 
-	return resp, nil
+	q.params = append(q.params, queryParam{
+		index:  len(q.headers),
+		field:  field,
+		column: column,
+		op:     operator,
+		name:   name,
+	})
+	q.headers = append(q.headers, field+": "+column+" "+operator+" <"+name+">")
+
+	return q
 }
 
-func (q Query) keepAlive() bool {
-	return q.keepalive
+func (q *Query) setErr(err error) {
+	if q.err == nil {
+		q.err = err
+	}
 }
 
-func (q *Query) parse(data []byte) ([]Record, error) {
+func (q Query) parse(data []byte) ([]string, []Record, error) {
 	var rows [][]interface{}
 
-	// Unmarshal received data
-	if err := json.Unmarshal(data, &rows); err != nil {
-		return nil, ParseError{
+	// Unmarshal received data, keeping numbers exact
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	err := dec.Decode(&rows)
+	if err == nil {
+		if _, terr := dec.Token(); terr != io.EOF {
+			err = fmt.Errorf("unexpected trailing data")
+		}
+	}
+	if err != nil {
+		return nil, nil, ParseError{
 			Message:    fmt.Sprintf("unmarshalling JSON failed: %v", err),
 			FailedData: data,
 		}
-	} else if len(q.columns) == 0 && len(rows) < 2 || len(q.columns) > 0 && len(rows) < 1 {
-		return nil, nil
+	} else if len(q.columns) == 0 && len(rows) < 1 {
+		return nil, nil, nil
 	}
 
-	// Extract q.columns names from first row if no column provided
-	if len(q.columns) == 0 {
-		q.columns = make([]string, len(rows[0]))
+	// Extract columns names from first row if no column provided
+	columns := q.columns
+	if len(columns) == 0 {
+		columns = make([]string, len(rows[0]))
 		for i, value := range rows[0] {
-			q.columns[i] = value.(string)
+			columns[i], _ = value.(string)
 		}
 		rows = rows[1:]
 	}
 
+	if len(rows) == 0 {
+		return columns, nil, nil
+	}
+
 	// Fill records maps
 	records := []Record{}
 	for _, row := range rows {
 		r := Record{}
 		for i, value := range row {
-			r[q.columns[i]] = value
+			r[columns[i]] = decodeNumbers(value)
 		}
 		records = append(records, r)
 	}
 
-	return records, nil
+	return columns, records, nil
+}
+
+// decodeNumbers replaces the JSON numbers found in a decoded value by int64 values for integers and float64 values
+// otherwise.
+func decodeNumbers(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+
+	case []interface{}:
+		for i, item := range v {
+			v[i] = decodeNumbers(item)
+		}
+
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = decodeNumbers(item)
+		}
+	}
+
+	return v
+}
+
+func formatRule(field, column, operator string, value interface{}) (string, error) {
+	if column == "" || strings.IndexFunc(column, isSeparator) != -1 {
+		return "", fmt.Errorf("invalid %s column name %q", field, column)
+	} else if _, ok := filterOperators[operator]; !ok {
+		return "", fmt.Errorf("invalid %s operator %q", field, operator)
+	}
+
+	s, err := formatValue(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s value for column %q: %v", field, column, err)
+	} else if err = checkValue(field, s); err != nil {
+		return "", err
+	}
+
+	return column + " " + operator + " " + s, nil
+}
+
+func isSeparator(r rune) bool {
+	return r <= ' ' || r == 0x7f
+}
+
+type queryParam struct {
+	index  int
+	field  string
+	column string
+	op     string
+	name   string
 }
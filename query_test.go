@@ -1,6 +1,7 @@
 package livestatus
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -360,13 +361,13 @@ func Test_QueryParse(t *testing.T) {
 	]`
 
 	expected := []Record{
-		{"name": "name1", "value": 123.0},
-		{"name": "name2", "value": 456.0},
+		{"name": "name1", "value": int64(123)},
+		{"name": "name2", "value": int64(456)},
 	}
 
 	q := NewQuery("table1")
 
-	result, err := q.parse([]byte(data))
+	_, result, err := q.parse([]byte(data))
 	if err != nil {
 		t.Fatal(err)
 	} else if !reflect.DeepEqual(result, expected) {
@@ -382,14 +383,14 @@ func Test_QueryParseWithColumns(t *testing.T) {
 	]`
 
 	expected := []Record{
-		{"name": "name1", "value": 123.0},
-		{"name": "name2", "value": 456.0},
+		{"name": "name1", "value": int64(123)},
+		{"name": "name2", "value": int64(456)},
 	}
 
 	q := NewQuery("table1")
 	q.Columns("name", "value")
 
-	result, err := q.parse([]byte(data))
+	_, result, err := q.parse([]byte(data))
 	if err != nil {
 		t.Fatal(err)
 	} else if !reflect.DeepEqual(result, expected) {
@@ -397,3 +398,254 @@ func Test_QueryParseWithColumns(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_QueryFilterValue(t *testing.T) {
+	expected := `GET table1
+Filter: column1 = abc def
+Filter: column2 >= 123
+Filter: column3 = 1
+Filter: column4 < 1439633040
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	q := NewQuery("table1")
+	q.FilterValue("column1", "=", "abc def")
+	q.FilterValue("column2", ">=", 123)
+	q.FilterValue("column3", "=", true)
+	q.FilterValue("column4", "<", time.Unix(1439633040, 0))
+
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	result := q.String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_QueryFilterValueUnsafe(t *testing.T) {
+	q := NewQuery("table1")
+	q.FilterValue("column1", "=", "abc\nKeepAlive: on")
+
+	err := q.Err()
+	if _, ok := err.(UnsafeValueError); !ok {
+		t.Logf("\nExpected UnsafeValueError\nbut got  %#v\n", err)
+		t.Fail()
+	}
+
+	for _, args := range [][]string{{"column 1", "="}, {"column1", "=="}} {
+		q = NewQuery("table1")
+		q.FilterValue(args[0], args[1], "abc")
+
+		if q.Err() == nil {
+			t.Logf("\nExpected error for %q\nbut got  nil\n", args)
+			t.Fail()
+		}
+	}
+}
+
+func Test_QueryWaitConditionValue(t *testing.T) {
+	expected := `GET table1
+WaitCondition: column1 = abc
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	q := NewQuery("table1")
+	q.WaitConditionValue("column1", "=", "abc")
+
+	result := q.String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_QueryStrict(t *testing.T) {
+	q := NewQuery("table1")
+	q.Filter("column1 = abc\nKeepAlive: on")
+
+	if err := q.Err(); err != nil {
+		t.Logf("\nExpected nil\nbut got  %#v\n", err)
+		t.Fail()
+	}
+
+	q.Strict()
+
+	err := q.Err()
+	if e, ok := err.(UnsafeValueError); !ok || e.Field != "Filter" {
+		t.Logf("\nExpected UnsafeValueError\nbut got  %#v\n", err)
+		t.Fail()
+	}
+}
+
+func Test_QueryStrictTable(t *testing.T) {
+	for _, table := range []string{"hosts\nAuthUser: x", "hosts x"} {
+		err := NewQuery(table).Strict().Err()
+		if e, ok := err.(UnsafeValueError); !ok || e.Field != "table" {
+			t.Logf("\nExpected UnsafeValueError\nbut got  %#v\n", err)
+			t.Fail()
+		}
+	}
+
+	if err := NewQuery("hosts").Strict().Err(); err != nil {
+		t.Logf("\nExpected nil\nbut got  %#v\n", err)
+		t.Fail()
+	}
+}
+
+func Test_QueryClone(t *testing.T) {
+	expected := `GET table1
+Columns: column1
+Filter: column1 ~ abc
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	q := NewQuery("table1")
+	q.Columns("column1")
+	q.Filter("column1 ~ abc")
+
+	clone := q.Clone()
+	clone.Filter("column2 >= 123")
+	clone.columns[0] = "column2"
+
+	result := q.String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	} else if q.columns[0] != "column1" {
+		t.Logf("\nExpected column1\nbut got  %q\n", q.columns[0])
+		t.Fail()
+	}
+}
+
+func Test_QueryFilterParamUnbound(t *testing.T) {
+	q := NewQuery("table1")
+	q.FilterParam("column1", "=", "param1")
+
+	if q.Err() == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+}
+
+func Test_QueryTruncated(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		return 200, `[["name1"],["name2"]]`
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	for _, tc := range []struct {
+		limit    int
+		expected bool
+	}{
+		{0, false},
+		{2, true},
+		{3, false},
+	} {
+		q := NewQuery("table1").Columns("name")
+		if tc.limit > 0 {
+			q.Limit(tc.limit)
+		}
+
+		resp, err := c.Exec(q)
+		if err != nil {
+			t.Fatal(err)
+		} else if resp.Truncated != tc.expected {
+			t.Logf("\nExpected %v for limit %d\nbut got  %v\n", tc.expected, tc.limit, resp.Truncated)
+			t.Fail()
+		}
+	}
+}
+
+func Test_QueryAuthUser(t *testing.T) {
+	expected := `GET table1
+AuthUser: user1
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	q := NewQuery("table1")
+	q.AuthUser("user1")
+
+	result := q.String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_QueryResponseError(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		return 404, "Invalid GET request, no such table 'table1'\n"
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	q := NewQuery("table1")
+
+	resp, err := c.Exec(q)
+	if !errors.Is(err, ErrNotFound) || !errors.Is(err, ErrInvalidQuery) {
+		t.Fatalf("\nExpected %#v\nbut got  %#v\n", ErrNotFound, err)
+	}
+
+	var rerr ResponseError
+	if !errors.As(err, &rerr) {
+		t.Fatalf("\nExpected ResponseError\nbut got  %#v\n", err)
+	}
+
+	expected := "Invalid GET request, no such table 'table1'"
+
+	if rerr.Status != 404 || rerr.Message != expected || rerr.Request != q.String() || rerr.Response != resp {
+		t.Logf("\nUnexpected response error %#v\n", rerr)
+		t.Fail()
+	} else if resp == nil || resp.Message != expected {
+		t.Logf("\nUnexpected partial response %#v\n", resp)
+		t.Fail()
+	}
+}
+
+func Test_QueryParseNumbers(t *testing.T) {
+	data := `[
+		["name1", 9007199254740993, 1.5, [1, 2.5], {"key": 3}]
+	]`
+
+	expected := []Record{
+		{
+			"name":   "name1",
+			"id":     int64(9007199254740993),
+			"ratio":  1.5,
+			"list":   []interface{}{int64(1), 2.5},
+			"object": map[string]interface{}{"key": int64(3)},
+		},
+	}
+
+	q := NewQuery("table1")
+	q.Columns("name", "id", "ratio", "list", "object")
+
+	_, result, err := q.parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+
+	if _, _, err := q.parse([]byte(data + ` x`)); err == nil {
+		t.Logf("\nExpected error on trailing data\nbut got  nil\n")
+		t.Fail()
+	}
+}
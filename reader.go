@@ -0,0 +1,90 @@
+package livestatus
+
+import (
+	"errors"
+	"time"
+)
+
+// RecordReader reads several columns from a record in one pass, collecting access errors instead of stopping at
+// the first one. Reading a faulty column returns the zero value of the expected type.
+type RecordReader struct {
+	record Record
+	errs   []error
+}
+
+// Reader returns a new reader for the record.
+func (r Record) Reader() *RecordReader {
+	return &RecordReader{record: r}
+}
+
+// Bool reads a boolean value for a specific column.
+func (rr *RecordReader) Bool(column string) bool {
+	v, err := rr.record.GetBool(column)
+	rr.collect(err)
+	return v
+}
+
+// Float reads a float value for a specific column.
+func (rr *RecordReader) Float(column string) float64 {
+	v, err := rr.record.GetFloat(column)
+	rr.collect(err)
+	return v
+}
+
+// Int reads an integer value for a specific column.
+func (rr *RecordReader) Int(column string) int64 {
+	v, err := rr.record.GetInt(column)
+	rr.collect(err)
+	return v
+}
+
+// String reads a string value for a specific column.
+func (rr *RecordReader) String(column string) string {
+	v, err := rr.record.GetString(column)
+	rr.collect(err)
+	return v
+}
+
+// Time reads a time struct value for a specific column.
+func (rr *RecordReader) Time(column string) time.Time {
+	v, err := rr.record.GetTime(column)
+	rr.collect(err)
+	return v
+}
+
+// StringSlice reads a slice of string values for a specific column.
+func (rr *RecordReader) StringSlice(column string) []string {
+	v, err := rr.record.GetStringSlice(column)
+	rr.collect(err)
+	return v
+}
+
+// IntSlice reads a slice of integer values for a specific column.
+func (rr *RecordReader) IntSlice(column string) []int64 {
+	v, err := rr.record.GetIntSlice(column)
+	rr.collect(err)
+	return v
+}
+
+// StringMap reads a map of string values for a specific dictionary column.
+func (rr *RecordReader) StringMap(column string) map[string]string {
+	v, err := rr.record.GetStringMap(column)
+	rr.collect(err)
+	return v
+}
+
+// Errors returns the list of errors encountered while reading the record.
+func (rr *RecordReader) Errors() []error {
+	return rr.errs
+}
+
+// Err returns an error joining all the errors encountered while reading the record, or nil if there are none.
+func (rr *RecordReader) Err() error {
+	return errors.Join(rr.errs...)
+}
+
+func (rr *RecordReader) collect(err error) {
+	if err != nil {
+		rr.errs = append(rr.errs, err)
+	}
+}
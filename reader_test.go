@@ -0,0 +1,43 @@
+package livestatus
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_RecordReader(t *testing.T) {
+	record := Record{
+		"name":   "name1",
+		"value":  123.0,
+		"groups": []interface{}{"group1"},
+	}
+
+	rr := record.Reader()
+
+	if v := rr.String("name"); v != "name1" {
+		t.Logf("\nExpected name1\nbut got  %#v\n", v)
+		t.Fail()
+	} else if v := rr.Int("value"); v != 123 {
+		t.Logf("\nExpected 123\nbut got  %#v\n", v)
+		t.Fail()
+	} else if v := rr.StringSlice("groups"); len(v) != 1 || v[0] != "group1" {
+		t.Logf("\nExpected [group1]\nbut got  %#v\n", v)
+		t.Fail()
+	} else if err := rr.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	rr.String("value")
+	rr.Int("unknown")
+
+	if n := len(rr.Errors()); n != 2 {
+		t.Logf("\nExpected 2 errors\nbut got  %d\n", n)
+		t.Fail()
+	}
+
+	err := rr.Err()
+	if !errors.Is(err, ErrInvalidType) || !errors.Is(err, ErrUnknownColumn) {
+		t.Logf("\nUnexpected error %#v\n", err)
+		t.Fail()
+	}
+}
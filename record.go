@@ -1,6 +1,8 @@
 package livestatus
 
 import (
+	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"time"
@@ -29,7 +31,7 @@ func (r Record) Columns() []string {
 func (r Record) Get(column string) (interface{}, error) {
 	v, ok := r[column]
 	if !ok {
-		return nil, ErrUnknownColumn
+		return nil, ColumnError{Column: column, Err: ErrUnknownColumn}
 	}
 
 	return v, nil
@@ -37,32 +39,42 @@ func (r Record) Get(column string) (interface{}, error) {
 
 // GetBool returns a boolean value for a specific column.
 func (r Record) GetBool(column string) (bool, error) {
-	v, err := r.getKey(reflect.Float64, column)
+	v, err := r.getNumber(column, "number")
 	if err != nil {
 		return false, err
 	}
 
-	return v == 1.0, nil
+	f, _ := toFloat(v)
+
+	return f == 1.0, nil
 }
 
 // GetFloat returns a float value for a specific column.
 func (r Record) GetFloat(column string) (float64, error) {
-	v, err := r.getKey(reflect.Float64, column)
+	v, err := r.getNumber(column, "number")
 	if err != nil {
 		return 0, err
 	}
 
-	return v.(float64), nil
+	f, _ := toFloat(v)
+
+	return f, nil
 }
 
-// GetInt returns an integer value for a specific column.
+// GetInt returns an integer value for a specific column. Numbers having a fractional part are reported as type
+// errors.
 func (r Record) GetInt(column string) (int64, error) {
-	v, err := r.getKey(reflect.Float64, column)
+	v, err := r.getNumber(column, "integer")
 	if err != nil {
 		return 0, err
 	}
 
-	return int64(v.(float64)), nil
+	n, ok := toInt(v)
+	if !ok {
+		return 0, typeError(column, "", "integer", v)
+	}
+
+	return n, nil
 }
 
 // GetSlice returns a slice of interface value for a specific column.
@@ -95,21 +107,237 @@ func (r Record) GetString(column string) (string, error) {
 
 // GetTime returns a time struct value for a specific column.
 func (r Record) GetTime(column string) (time.Time, error) {
-	v, err := r.getKey(reflect.Float64, column)
+	v, err := r.getNumber(column, "timestamp")
 	if err != nil {
 		return time.Time{}, err
 	}
 
+	if n, ok := v.(int64); ok {
+		return time.Unix(n, 0), nil
+	}
+
 	return time.Unix(int64(v.(float64)), 0), nil
 }
 
+// GetBoolOr returns a boolean value for a specific column, or a default value if the column is missing or
+// doesn't have the expected type.
+func (r Record) GetBoolOr(column string, def bool) bool {
+	v, err := r.GetBool(column)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
+// GetFloatOr returns a float value for a specific column, or a default value if the column is missing or doesn't
+// have the expected type.
+func (r Record) GetFloatOr(column string, def float64) float64 {
+	v, err := r.GetFloat(column)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
+// GetIntOr returns an integer value for a specific column, or a default value if the column is missing or doesn't
+// have the expected type.
+func (r Record) GetIntOr(column string, def int64) int64 {
+	v, err := r.GetInt(column)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
+// GetStringOr returns a string value for a specific column, or a default value if the column is missing or
+// doesn't have the expected type.
+func (r Record) GetStringOr(column string, def string) string {
+	v, err := r.GetString(column)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
+// GetTimeOr returns a time struct value for a specific column, or a default value if the column is missing or
+// doesn't have the expected type.
+func (r Record) GetTimeOr(column string, def time.Time) time.Time {
+	v, err := r.GetTime(column)
+	if err != nil {
+		return def
+	}
+
+	return v
+}
+
 func (r Record) getKey(k reflect.Kind, column string) (interface{}, error) {
 	val, ok := r[column]
 	if !ok {
-		return nil, ErrUnknownColumn
+		return nil, ColumnError{Column: column, Err: ErrUnknownColumn}
 	} else if vk := reflect.ValueOf(val).Kind(); vk != k {
-		return nil, ErrInvalidType
+		return nil, typeError(column, "", kindNames[k], val)
+	}
+
+	return val, nil
+}
+
+func (r Record) getNumber(column, expected string) (interface{}, error) {
+	val, ok := r[column]
+	if !ok {
+		return nil, ColumnError{Column: column, Err: ErrUnknownColumn}
+	} else if _, ok := toFloat(val); !ok {
+		return nil, typeError(column, "", expected, val)
 	}
 
 	return val, nil
 }
+
+// GetStringSlice returns a slice of string values for a specific column.
+func (r Record) GetStringSlice(column string) ([]string, error) {
+	values, err := r.GetSlice(column)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, len(values))
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, elementError(column, i, "string", v)
+		}
+		out[i] = s
+	}
+
+	return out, nil
+}
+
+// GetIntSlice returns a slice of integer values for a specific column.
+func (r Record) GetIntSlice(column string) ([]int64, error) {
+	values, err := r.GetSlice(column)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]int64, len(values))
+	for i, v := range values {
+		n, ok := toInt(v)
+		if !ok {
+			return nil, elementError(column, i, "integer", v)
+		}
+		out[i] = n
+	}
+
+	return out, nil
+}
+
+// GetTimeSlice returns a slice of time struct values for a specific column.
+func (r Record) GetTimeSlice(column string) ([]time.Time, error) {
+	values, err := r.GetSlice(column)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]time.Time, len(values))
+	for i, v := range values {
+		n, ok := toInt(v)
+		if !ok {
+			return nil, elementError(column, i, "timestamp", v)
+		}
+		out[i] = time.Unix(n, 0)
+	}
+
+	return out, nil
+}
+
+// GetStringMap returns a map of string values for a specific dictionary column (e.g. `custom_variables`).
+func (r Record) GetStringMap(column string) (map[string]string, error) {
+	v, err := r.getKey(reflect.Map, column)
+	if err != nil {
+		return nil, err
+	}
+
+	values, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, typeError(column, "", "object", v)
+	}
+
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, typeError(column, fmt.Sprintf("[%q]", k), "string", v)
+		}
+		out[k] = s
+	}
+
+	return out, nil
+}
+
+var kindNames = map[reflect.Kind]string{
+	reflect.Map:    "object",
+	reflect.Slice:  "array",
+	reflect.String: "string",
+}
+
+func elementError(column string, index int, expected string, v interface{}) error {
+	return typeError(column, fmt.Sprintf("[%d]", index), expected, v)
+}
+
+func typeError(column, path, expected string, v interface{}) error {
+	return ColumnError{
+		Column:   column,
+		Path:     path,
+		Expected: expected,
+		Actual:   jsonType(v),
+		Value:    v,
+		Err:      ErrInvalidType,
+	}
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64, int64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+
+	return fmt.Sprintf("%T", v)
+}
+
+func toInt(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, false
+		}
+		return int64(v), true
+	}
+
+	return 0, false
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+
+	return 0, false
+}
@@ -1,6 +1,7 @@
 package livestatus
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -192,10 +193,16 @@ func Test_RecordGetInvalidType(t *testing.T) {
 	}
 
 	_, err := record.GetInt("value")
-	if err != ErrInvalidType {
+	if !errors.Is(err, ErrInvalidType) {
 		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrInvalidType, err)
 		t.Fail()
 	}
+
+	var cerr ColumnError
+	if !errors.As(err, &cerr) || cerr.Column != "value" || cerr.Expected != "integer" || cerr.Actual != "string" {
+		t.Logf("\nUnexpected column error %#v\n", err)
+		t.Fail()
+	}
 }
 
 func Test_RecordGetUnknownColumn(t *testing.T) {
@@ -205,8 +212,161 @@ func Test_RecordGetUnknownColumn(t *testing.T) {
 	}
 
 	_, err := record.GetInt("unknown")
-	if err != ErrUnknownColumn {
+	if !errors.Is(err, ErrUnknownColumn) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrInvalidType, err)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetStringSlice(t *testing.T) {
+	record := Record{
+		"value":   []interface{}{"value1", "value2"},
+		"invalid": []interface{}{"value1", 2.0},
+	}
+
+	expected := []string{"value1", "value2"}
+
+	result, err := record.GetStringSlice("value")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+
+	if _, err = record.GetStringSlice("invalid"); !errors.Is(err, ErrInvalidType) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrInvalidType, err)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetIntSlice(t *testing.T) {
+	record := Record{
+		"value":   []interface{}{1.0, 2.0},
+		"invalid": []interface{}{1.5},
+	}
+
+	expected := []int64{1, 2}
+
+	result, err := record.GetIntSlice("value")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+
+	if _, err = record.GetIntSlice("invalid"); !errors.Is(err, ErrInvalidType) {
 		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrInvalidType, err)
 		t.Fail()
 	}
 }
+
+func Test_RecordGetTimeSlice(t *testing.T) {
+	record := Record{
+		"value": []interface{}{0.0, 1.439633040e9},
+	}
+
+	expected := []time.Time{time.Unix(0, 0), time.Unix(1439633040, 0)}
+
+	result, err := record.GetTimeSlice("value")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetStringMap(t *testing.T) {
+	record := Record{
+		"value":   map[string]interface{}{"KEY1": "value1", "KEY2": "value2"},
+		"invalid": map[string]interface{}{"KEY1": 1.0},
+		"slice":   []interface{}{},
+	}
+
+	expected := map[string]string{"KEY1": "value1", "KEY2": "value2"}
+
+	result, err := record.GetStringMap("value")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+
+	for _, column := range []string{"invalid", "slice"} {
+		if _, err = record.GetStringMap(column); !errors.Is(err, ErrInvalidType) {
+			t.Logf("\nExpected %#v\nbut got  %#v\n", ErrInvalidType, err)
+			t.Fail()
+		}
+	}
+}
+
+func Test_RecordGetOr(t *testing.T) {
+	record := Record{
+		"name":  "name1",
+		"value": 123.0,
+	}
+
+	if v := record.GetStringOr("name", "default"); v != "name1" {
+		t.Logf("\nExpected name1\nbut got  %#v\n", v)
+		t.Fail()
+	} else if v := record.GetStringOr("value", "default"); v != "default" {
+		t.Logf("\nExpected default\nbut got  %#v\n", v)
+		t.Fail()
+	} else if v := record.GetIntOr("unknown", 456); v != 456 {
+		t.Logf("\nExpected 456\nbut got  %#v\n", v)
+		t.Fail()
+	} else if v := record.GetFloatOr("value", 0); v != 123.0 {
+		t.Logf("\nExpected 123\nbut got  %#v\n", v)
+		t.Fail()
+	} else if v := record.GetBoolOr("name", true); !v {
+		t.Logf("\nExpected true\nbut got  %#v\n", v)
+		t.Fail()
+	} else if v := record.GetTimeOr("name", time.Unix(0, 0)); !v.Equal(time.Unix(0, 0)) {
+		t.Logf("\nExpected %s\nbut got  %s\n", time.Unix(0, 0), v)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetElementError(t *testing.T) {
+	record := Record{
+		"value": []interface{}{"value1", 2.0},
+	}
+
+	_, err := record.GetStringSlice("value")
+
+	var cerr ColumnError
+	if !errors.As(err, &cerr) || cerr.Path != "[1]" || cerr.Actual != "number" {
+		t.Logf("\nUnexpected column error %#v\n", err)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetNumbers(t *testing.T) {
+	record := Record{
+		"id":       int64(9007199254740993),
+		"integral": 123.0,
+		"ratio":    1.5,
+	}
+
+	if v, err := record.GetInt("id"); err != nil || v != 9007199254740993 {
+		t.Logf("\nExpected %d\nbut got  %d (%v)\n", int64(9007199254740993), v, err)
+		t.Fail()
+	} else if v, err := record.GetInt("integral"); err != nil || v != 123 {
+		t.Logf("\nExpected 123\nbut got  %d (%v)\n", v, err)
+		t.Fail()
+	} else if v, err := record.GetFloat("id"); err != nil || v != 9007199254740993.0 {
+		t.Logf("\nExpected %f\nbut got  %f (%v)\n", 9007199254740993.0, v, err)
+		t.Fail()
+	}
+
+	_, err := record.GetInt("ratio")
+
+	var cerr ColumnError
+	if !errors.As(err, &cerr) || cerr.Expected != "integer" || cerr.Actual != "number" {
+		t.Logf("\nUnexpected column error %#v\n", err)
+		t.Fail()
+	}
+}
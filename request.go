@@ -6,6 +6,6 @@ import "net"
 type Request interface {
 	String() string
 
-	handle(net.Conn) (*Response, error)
+	handle(net.Conn, Clock) (*Response, error)
 	keepAlive() bool
 }
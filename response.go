@@ -1,13 +1,82 @@
 package livestatus
 
+import (
+	"sort"
+)
+
 // Response represents a Livestatus query response.
 type Response struct {
 	Status  int
 	Message string
 	Records []Record
+
+	// Columns contains the names of the response columns, in the order they were requested or returned.
+	Columns []string
+
+	// Truncated reports whether the number of records reached the limit set on the query, meaning that some
+	// records have possibly been left out by Livestatus.
+	Truncated bool
+}
+
+// MergeResponses merges several responses into a new one, concatenating their records. The resulting response
+// status is the highest status of the merged ones, and it is marked as truncated if any of them is.
+func MergeResponses(resps ...*Response) *Response {
+	out := &Response{}
+
+	for _, r := range resps {
+		if r == nil {
+			continue
+		}
+
+		if r.Status > out.Status {
+			out.Status = r.Status
+			out.Message = r.Message
+		}
+		if len(out.Columns) == 0 {
+			out.Columns = r.Columns
+		}
+		out.Records = append(out.Records, r.Records...)
+		out.Truncated = out.Truncated || r.Truncated
+	}
+
+	return out
 }
 
 // Len returns the number of records present in the response.
 func (r Response) Len() int {
 	return len(r.Records)
 }
+
+// Sort sorts the response records in place using the given keys, by order of precedence. The sort is stable,
+// records being equal for all the keys keeping their original order.
+func (r *Response) Sort(keys ...SortKey) {
+	sort.SliceStable(r.Records, func(i, j int) bool {
+		for _, k := range keys {
+			c := k.compare(r.Records[i][k.Column], r.Records[j][k.Column])
+			if c != 0 {
+				return c < 0
+			}
+		}
+
+		return false
+	})
+}
+
+// Window returns a new response containing at most limit records starting at the given offset. A limit lower
+// or equal to 0 disables the limit.
+func (r Response) Window(offset, limit int) *Response {
+	out := r
+
+	if offset < 0 {
+		offset = 0
+	} else if offset > len(r.Records) {
+		offset = len(r.Records)
+	}
+	out.Records = r.Records[offset:]
+
+	if limit > 0 && limit < len(out.Records) {
+		out.Records = out.Records[:limit]
+	}
+
+	return &out
+}
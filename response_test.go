@@ -1,6 +1,7 @@
 package livestatus
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -18,3 +19,97 @@ func Test_ResponseLen(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_ResponseSort(t *testing.T) {
+	resp := Response{
+		Records: []Record{
+			{"name": "name1", "state": 2.0, "last_check": 30.0},
+			{"name": "name2", "state": 3.0, "last_check": 10.0},
+			{"name": "name3", "state": 0.0, "last_check": 20.0},
+			{"name": "name4", "state": 2.0, "last_check": 40.0},
+			{"name": "name5", "state": 1.0},
+		},
+	}
+
+	for _, tc := range []struct {
+		keys     []SortKey
+		expected []string
+	}{
+		{[]SortKey{Desc("name")}, []string{"name5", "name4", "name3", "name2", "name1"}},
+		{[]SortKey{Asc("last_check")}, []string{"name5", "name2", "name3", "name1", "name4"}},
+		{
+			[]SortKey{{Column: "state", Desc: true, Order: SortServiceState}, Desc("last_check")},
+			[]string{"name4", "name1", "name2", "name5", "name3"},
+		},
+		{[]SortKey{{Column: "state", Order: SortHostState}}, []string{"name3", "name4", "name1", "name5", "name2"}},
+	} {
+		resp.Sort(tc.keys...)
+
+		result := []string{}
+		for _, r := range resp.Records {
+			result = append(result, r["name"].(string))
+		}
+
+		if !reflect.DeepEqual(result, tc.expected) {
+			t.Logf("\nExpected %#v\nbut got  %#v\n", tc.expected, result)
+			t.Fail()
+		}
+	}
+}
+
+func Test_ResponseWindow(t *testing.T) {
+	resp := Response{
+		Status: 200,
+		Records: []Record{
+			{"name": "name1"},
+			{"name": "name2"},
+			{"name": "name3"},
+		},
+	}
+
+	for _, tc := range []struct {
+		offset, limit int
+		expected      int
+	}{
+		{0, 0, 3},
+		{1, 0, 2},
+		{1, 1, 1},
+		{2, 5, 1},
+		{5, 1, 0},
+	} {
+		result := resp.Window(tc.offset, tc.limit)
+		if result.Len() != tc.expected {
+			t.Logf("\nExpected %d records for %d/%d\nbut got  %d\n", tc.expected, tc.offset, tc.limit, result.Len())
+			t.Fail()
+		}
+	}
+}
+
+func Test_MergeResponses(t *testing.T) {
+	result := MergeResponses(
+		&Response{Status: 200, Records: []Record{{"name": "name1"}}},
+		nil,
+		&Response{Status: 200, Records: []Record{{"name": "name2"}}, Truncated: true},
+	)
+
+	if result.Len() != 2 || !result.Truncated || result.Status != 200 {
+		t.Logf("\nUnexpected merged response %#v\n", result)
+		t.Fail()
+	}
+}
+
+func Test_ResponseSortLargeIntegers(t *testing.T) {
+	resp := Response{
+		Records: []Record{
+			{"name": "name1", "id": int64(9007199254740993)},
+			{"name": "name2", "id": int64(9007199254740992)},
+		},
+	}
+
+	resp.Sort(Asc("id"))
+
+	if name := resp.Records[0]["name"]; name != "name2" {
+		t.Logf("\nExpected %q\nbut got  %q\n", "name2", name)
+		t.Fail()
+	}
+}
@@ -0,0 +1,154 @@
+package livestatus
+
+import (
+	"strings"
+	"time"
+)
+
+// SortOrder represents the ordering applied when comparing column values.
+type SortOrder int
+
+const (
+	// SortNatural compares values according to their type: numerically for numbers and timestamps,
+	// lexicographically for strings.
+	SortNatural SortOrder = iota
+	// SortHostState compares host state codes by severity (UP, UNREACHABLE then DOWN).
+	SortHostState
+	// SortServiceState compares service state codes by severity (OK, WARNING, UNKNOWN then CRITICAL).
+	SortServiceState
+)
+
+var (
+	hostStateSeverity    = map[int64]int{0: 0, 2: 1, 1: 2}
+	serviceStateSeverity = map[int64]int{0: 0, 1: 1, 3: 2, 2: 3}
+)
+
+// SortKey represents a response records sorting key.
+type SortKey struct {
+	Column string
+	Desc   bool
+	Order  SortOrder
+}
+
+// Asc returns an ascending sorting key for a given column.
+func Asc(column string) SortKey {
+	return SortKey{Column: column}
+}
+
+// Desc returns a descending sorting key for a given column.
+func Desc(column string) SortKey {
+	return SortKey{Column: column, Desc: true}
+}
+
+func (k SortKey) compare(a, b interface{}) int {
+	c := compareValues(a, b, k.Order)
+	if k.Desc {
+		return -c
+	}
+
+	return c
+}
+
+func compareValues(a, b interface{}, order SortOrder) int {
+	// Missing values always come first
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	// Compare integers directly to avoid losing precision on large values
+	if ia, ok := a.(int64); ok {
+		if ib, ok := b.(int64); ok && order == SortNatural {
+			switch {
+			case ia < ib:
+				return -1
+			case ia > ib:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	if fa, ok := toNumber(a); ok {
+		if fb, ok := toNumber(b); ok {
+			switch order {
+			case SortHostState:
+				return compareSeverity(fa, fb, hostStateSeverity)
+			case SortServiceState:
+				return compareSeverity(fa, fb, serviceStateSeverity)
+			}
+
+			return compareFloats(fa, fb)
+		}
+	}
+
+	if sa, ok := a.(string); ok {
+		if sb, ok := b.(string); ok {
+			return strings.Compare(sa, sb)
+		}
+	}
+
+	// Fallback on comparing values of mismatching types by their type ordering
+	return compareFloats(float64(typeRank(a)), float64(typeRank(b)))
+}
+
+func compareSeverity(a, b float64, severity map[int64]int) int {
+	sa, ok := severity[int64(a)]
+	if !ok {
+		sa = len(severity)
+	}
+
+	sb, ok := severity[int64(b)]
+	if !ok {
+		sb = len(severity)
+	}
+
+	return compareFloats(float64(sa), float64(sb))
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func toNumber(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case time.Time:
+		return float64(v.UnixNano()) / float64(time.Second), true
+	}
+
+	return 0, false
+}
+
+func typeRank(v interface{}) int {
+	if _, ok := toNumber(v); ok {
+		return 0
+	} else if _, ok := v.(string); ok {
+		return 1
+	}
+
+	return 2
+}
@@ -0,0 +1,104 @@
+package livestatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+)
+
+// Stream executes a given Livestatus query, decoding its response incrementally and calling fn for each record
+// instead of storing them. Execution stops on the first error returned by fn, which is then returned.
+//
+// The returned response holds the status, columns and truncation state, but no records.
+func (c *Client) Stream(q *Query, fn func(Record) error) (*Response, error) {
+	r := &streamRequest{Query: q, fn: fn}
+
+	resp, err := c.Exec(r)
+	if err != nil && r.aborted {
+		// Remaining response data is left unread, preventing the connection from being reused
+		c.Close()
+	}
+
+	return resp, err
+}
+
+type streamRequest struct {
+	*Query
+	fn      func(Record) error
+	aborted bool
+}
+
+func (r *streamRequest) handle(conn net.Conn, clock Clock) (*Response, error) {
+	resp, body, err := r.Query.open(conn, clock)
+	if err != nil {
+		return resp, err
+	}
+
+	// Records are decoded while being read, thus any failure leaves the connection in an unknown state
+	r.aborted = true
+
+	dec := json.NewDecoder(body)
+	dec.UseNumber()
+
+	if tok, err := dec.Token(); err != nil {
+		if err == io.EOF {
+			// Empty response body
+			r.aborted = false
+			return resp, nil
+		}
+		return nil, streamError(err)
+	} else if tok != json.Delim('[') {
+		return nil, streamError(fmt.Errorf("unexpected token %v", tok))
+	}
+
+	count := 0
+
+	for dec.More() {
+		var row []interface{}
+		if err := dec.Decode(&row); err != nil {
+			return nil, streamError(err)
+		}
+
+		// Extract columns names from first row if no column provided
+		if len(resp.Columns) == 0 {
+			resp.Columns = make([]string, len(row))
+			for i, value := range row {
+				resp.Columns[i], _ = value.(string)
+			}
+			continue
+		} else if len(row) != len(resp.Columns) {
+			return nil, streamError(fmt.Errorf("expected %d values but got %d", len(resp.Columns), len(row)))
+		}
+
+		rec := make(Record, len(row))
+		for i, value := range row {
+			rec[resp.Columns[i]] = decodeNumbers(value)
+		}
+
+		if err := r.fn(rec); err != nil {
+			return nil, err
+		}
+		count++
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, streamError(err)
+	}
+
+	// Consume the trailing data (e.g. newline), preventing it from being read as the next response
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return nil, err
+	}
+	r.aborted = false
+
+	resp.Truncated = r.Query.limit > 0 && count >= r.Query.limit
+
+	return resp, nil
+}
+
+func streamError(err error) error {
+	return fmt.Errorf("parsing read data as records failed: %w", ParseError{
+		Message: fmt.Sprintf("decoding JSON failed: %v", err),
+	})
+}
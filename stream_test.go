@@ -0,0 +1,105 @@
+package livestatus
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_ClientStream(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		return 200, `[["name","value"],["name1",123],["name2",9007199254740993]]`
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	expected := []Record{
+		{"name": "name1", "value": int64(123)},
+		{"name": "name2", "value": int64(9007199254740993)},
+	}
+
+	records := []Record{}
+
+	resp, err := c.Stream(NewQuery("table1"), func(r Record) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(records, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, records)
+		t.Fail()
+	} else if !reflect.DeepEqual(resp.Columns, []string{"name", "value"}) || resp.Records != nil {
+		t.Logf("\nUnexpected response %#v\n", resp)
+		t.Fail()
+	}
+
+	// Ensure callback errors stop the stream
+	errStop := errors.New("stop")
+	count := 0
+
+	_, err = c.Stream(NewQuery("table1").KeepAlive(), func(r Record) error {
+		count++
+		return errStop
+	})
+	if err != errStop || count != 1 {
+		t.Logf("\nExpected %#v after 1 record\nbut got  %#v after %d\n", errStop, err, count)
+		t.Fail()
+	}
+
+	// Ensure the client recovers from the aborted stream
+	resp, err = c.Exec(NewQuery("table1"))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(resp.Records) != 2 {
+		t.Logf("\nExpected 2 records\nbut got  %d\n", len(resp.Records))
+		t.Fail()
+	}
+}
+
+func Test_ClientStreamKeepAlive(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		// Trailing data exceeding the decoder buffer must not be left on the connection
+		return 200, `[["name1",123]]` + strings.Repeat(" ", 8192) + "\n"
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	for i := 0; i < 2; i++ {
+		count := 0
+
+		_, err := c.Stream(NewQuery("table1").Columns("name", "value").KeepAlive(), func(r Record) error {
+			count++
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		} else if count != 1 {
+			t.Logf("\nExpected 1 record\nbut got  %d\n", count)
+			t.Fail()
+		}
+	}
+}
+
+func Test_ClientStreamParseError(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		return 200, `[["name1",123]`
+	})
+	defer stop()
+
+	c := NewClient("tcp", addr)
+	defer c.Close()
+
+	_, err := c.Stream(NewQuery("table1").Columns("name", "value"), func(r Record) error { return nil })
+
+	var perr ParseError
+	if !errors.As(err, &perr) {
+		t.Logf("\nExpected ParseError\nbut got  %#v\n", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,213 @@
+package livestatus
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// DefaultSubscriptionTimeout is the default upper limit on the time a subscription query waits for its
+	// trigger to fire.
+	DefaultSubscriptionTimeout = 30 * time.Second
+
+	// DefaultSubscriptionInterval is the default minimum interval between two records deliveries.
+	DefaultSubscriptionInterval = time.Second
+
+	subscriptionReadMargin = 10 * time.Second
+)
+
+// Trigger represents a Livestatus wait trigger, the event broker message type causing wait conditions to be
+// checked again.
+type Trigger string
+
+// Wait triggers
+const (
+	TriggerCheck    Trigger = "check"
+	TriggerState    Trigger = "state"
+	TriggerLog      Trigger = "log"
+	TriggerDowntime Trigger = "downtime"
+	TriggerComment  Trigger = "comment"
+	TriggerCommand  Trigger = "command"
+	TriggerProgram  Trigger = "program"
+	TriggerAll      Trigger = "all"
+)
+
+// Subscription represents a push-style Livestatus subscription, repeatedly issuing wait queries and delivering
+// their records each time the trigger fires.
+//
+// Without wait condition, records are delivered each time the trigger fires. With wait conditions, records are
+// delivered as soon as the conditions are fulfilled, thus conditions remaining true are delivered repeatedly no
+// more often than the subscription interval.
+type Subscription struct {
+	client   *Client
+	query    *Query
+	trigger  Trigger
+	object   string
+	conds    *Query
+	timeout  time.Duration
+	interval time.Duration
+	backoff  backoff
+	onError  func(error)
+}
+
+// NewSubscription creates a new subscription on a given query (defining the table, columns and filters) for a
+// specific trigger. The subscription uses its own connection, based on the client network settings.
+func NewSubscription(c *Client, q *Query, trigger Trigger) *Subscription {
+	return &Subscription{
+		client:   c.clone(),
+		query:    q.Clone(),
+		trigger:  trigger,
+		conds:    &Query{},
+		timeout:  DefaultSubscriptionTimeout,
+		interval: DefaultSubscriptionInterval,
+		backoff:  backoff{min: defaultMinBackoff, max: defaultMaxBackoff},
+	}
+}
+
+// Object sets the object to wait on (see Query.WaitObject).
+func (s *Subscription) Object(name string) *Subscription {
+	s.object = name
+	return s
+}
+
+// Condition appends a new wait condition to the subscription.
+func (s *Subscription) Condition(rule string) *Subscription {
+	s.conds.WaitCondition(rule)
+	return s
+}
+
+// ConditionValue appends a new wait condition to the subscription, comparing a column with a value using a given
+// operator.
+func (s *Subscription) ConditionValue(column, operator string, value interface{}) *Subscription {
+	s.conds.WaitConditionValue(column, operator, value)
+	return s
+}
+
+// Timeout sets the upper limit on the time each wait query waits for the trigger to fire. A value of 0 waits
+// forever.
+//
+// Livestatus responses don't tell whether the trigger fired or the timeout expired, thus results of queries lasting
+// at least the timeout are considered as ended by the timeout expiry and are not delivered. Results of a trigger
+// firing right before the timeout expiry can thus be missed, and are delivered by the next wait query only if the
+// trigger fires again.
+func (s *Subscription) Timeout(d time.Duration) *Subscription {
+	s.timeout = d
+	return s
+}
+
+// Interval sets the minimum interval between two records deliveries.
+func (s *Subscription) Interval(d time.Duration) *Subscription {
+	s.interval = d
+	return s
+}
+
+// Backoff sets the minimum and maximum delays to wait before reconnecting after a failure (e.g. a monitoring core
+// restart), the delay being doubled after each consecutive failure.
+func (s *Subscription) Backoff(min, max time.Duration) *Subscription {
+	s.backoff = backoff{min: min, max: max}
+	return s
+}
+
+// OnError sets a function called each time a wait query fails.
+func (s *Subscription) OnError(fn func(error)) *Subscription {
+	s.onError = fn
+	return s
+}
+
+// Query returns the wait query issued by the subscription.
+func (s *Subscription) Query() *Query {
+	q := s.query.Clone()
+	if s.object != "" {
+		q.WaitObject(s.object)
+	}
+	q.headers = append(q.headers, s.conds.headers...)
+	q.setErr(s.conds.err)
+	q.WaitTrigger(string(s.trigger))
+	q.WaitTimeout(s.timeout)
+	if s.timeout > 0 {
+		q.ReadTimeout(s.timeout + subscriptionReadMargin)
+	}
+	q.KeepAlive()
+
+	return q
+}
+
+// Run runs the subscription until the context is cancelled, calling fn with the records returned each time the
+// trigger fires.
+func (s *Subscription) Run(ctx context.Context, fn func([]Record)) error {
+	q := s.Query()
+	if err := q.Err(); err != nil {
+		return err
+	}
+
+	defer s.client.Close()
+
+	// Close the connection on cancellation to interrupt any pending wait query
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.client.Close()
+		case <-stop:
+		}
+	}()
+
+	for {
+		// Measure the wait duration using the system clock, the client clock being used for timestamps only
+		start := time.Now()
+
+		resp, err := s.client.Exec(q)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		} else if err != nil {
+			s.client.Close()
+
+			if s.onError != nil {
+				s.onError(err)
+			}
+
+			if !sleepContext(ctx, s.backoff.next()) {
+				return ctx.Err()
+			}
+			continue
+		}
+
+		s.backoff.reset()
+
+		elapsed := time.Since(start)
+		if s.timeout > 0 && elapsed >= s.timeout {
+			// Wait timeout most likely expired without the trigger firing (see Timeout)
+			continue
+		}
+
+		fn(resp.Records)
+
+		if delay := s.interval - elapsed; delay > 0 && !sleepContext(ctx, delay) {
+			return ctx.Err()
+		}
+	}
+}
+
+// Records runs the subscription in a separate goroutine until the context is cancelled, returning a channel on
+// which the records are sent. The channel is closed once the subscription is stopped.
+func (s *Subscription) Records(ctx context.Context) <-chan []Record {
+	ch := make(chan []Record)
+
+	go func() {
+		defer close(ch)
+
+		err := s.Run(ctx, func(records []Record) {
+			select {
+			case ch <- records:
+			case <-ctx.Done():
+			}
+		})
+		if err != nil && err != ctx.Err() && s.onError != nil {
+			s.onError(err)
+		}
+	}()
+
+	return ch
+}
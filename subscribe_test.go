@@ -0,0 +1,133 @@
+package livestatus
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_SubscriptionQuery(t *testing.T) {
+	expected := `GET hosts
+Columns: name state
+WaitObject: host1
+WaitCondition: state != 0
+WaitTrigger: state
+WaitTimeout: 5000
+KeepAlive: on
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	s := NewSubscription(NewClient("tcp", "127.0.0.1:0"), NewQuery("hosts").Columns("name", "state"), TriggerState).
+		Object("host1").
+		ConditionValue("state", "!=", 0).
+		Timeout(5 * time.Second)
+
+	result := s.Query().String()
+	if result != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_SubscriptionQueryNoTimeout(t *testing.T) {
+	q := NewSubscription(NewClient("tcp", "127.0.0.1:0"), NewQuery("hosts"), TriggerState).
+		Timeout(0).
+		Query()
+
+	if !strings.Contains(q.String(), "WaitTimeout: 0\n") {
+		t.Logf("\nExpected WaitTimeout header\nbut got  %q\n", q.String())
+		t.Fail()
+	}
+
+	if q.readTimeout != 0 {
+		t.Logf("\nExpected no read timeout\nbut got  %s\n", q.readTimeout)
+		t.Fail()
+	}
+}
+
+func Test_Subscription(t *testing.T) {
+	var count int32
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		if !strings.Contains(req, "WaitTrigger: all\n") {
+			return 400, "missing trigger\n"
+		}
+
+		switch atomic.AddInt32(&count, 1) {
+		case 1:
+			// Simulate wait timeout expiry
+			time.Sleep(60 * time.Millisecond)
+		case 2:
+			// Simulate core restart
+			return 500, "restarting\n"
+		}
+
+		return 200, `[["host1",1]]`
+	})
+	defer stop()
+
+	errors := int32(0)
+
+	// A frozen client clock must not prevent the wait timeout expiry detection
+	c := NewClient("tcp", addr)
+	c.SetClock(fakeClock{now: time.Unix(1439633040, 0)})
+
+	s := NewSubscription(c, NewQuery("hosts").Columns("name", "state"), TriggerAll).
+		Timeout(50*time.Millisecond).
+		Interval(time.Millisecond).
+		Backoff(time.Millisecond, time.Millisecond).
+		OnError(func(err error) { atomic.AddInt32(&errors, 1) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	deliveries := 0
+	for records := range s.Records(ctx) {
+		if len(records) != 1 || records[0]["name"] != "host1" {
+			t.Logf("\nUnexpected records %#v\n", records)
+			t.Fail()
+		}
+
+		deliveries++
+		if deliveries == 2 {
+			cancel()
+		}
+	}
+
+	if deliveries != 2 {
+		t.Logf("\nExpected 2 deliveries\nbut got  %d\n", deliveries)
+		t.Fail()
+	} else if n := atomic.LoadInt32(&count); n != 4 {
+		t.Logf("\nExpected 4 queries\nbut got  %d\n", n)
+		t.Fail()
+	} else if n := atomic.LoadInt32(&errors); n != 1 {
+		t.Logf("\nExpected 1 error\nbut got  %d\n", n)
+		t.Fail()
+	}
+}
+
+func Test_SubscriptionCancel(t *testing.T) {
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		time.Sleep(time.Second)
+		return 200, `[]`
+	})
+	defer stop()
+
+	s := NewSubscription(NewClient("tcp", addr), NewQuery("hosts"), TriggerAll)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := s.Run(ctx, func([]Record) {}); err != context.DeadlineExceeded {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", context.DeadlineExceeded, err)
+		t.Fail()
+	} else if d := time.Since(start); d > 500*time.Millisecond {
+		t.Logf("\nExpected pending query to be interrupted\nbut took %s\n", d)
+		t.Fail()
+	}
+}
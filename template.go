@@ -0,0 +1,142 @@
+package livestatus
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParamType represents the type of a query template placeholder.
+type ParamType int
+
+const (
+	// ParamString represents a string placeholder.
+	ParamString ParamType = iota
+	// ParamInt represents an integer placeholder.
+	ParamInt
+	// ParamFloat represents a floating point number placeholder, also accepting integers.
+	ParamFloat
+	// ParamBool represents a boolean placeholder.
+	ParamBool
+	// ParamTime represents a time placeholder, rendered as an UNIX timestamp.
+	ParamTime
+)
+
+func (t ParamType) String() string {
+	switch t {
+	case ParamString:
+		return "string"
+	case ParamInt:
+		return "int"
+	case ParamFloat:
+		return "float"
+	case ParamBool:
+		return "bool"
+	case ParamTime:
+		return "time"
+	}
+
+	return fmt.Sprintf("ParamType(%d)", int(t))
+}
+
+func (t ParamType) accepts(v interface{}) bool {
+	switch v.(type) {
+	case string:
+		return t == ParamString
+
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return t == ParamInt || t == ParamFloat
+
+	case float32, float64:
+		return t == ParamFloat
+
+	case bool:
+		return t == ParamBool
+
+	case time.Time:
+		return t == ParamTime
+	}
+
+	return false
+}
+
+// Params represents the values bound to query template placeholders.
+type Params map[string]interface{}
+
+// QueryTemplate represents an immutable Livestatus query containing named placeholders, from which independent
+// queries can be produced by binding values to them. A template is safe for concurrent use.
+type QueryTemplate struct {
+	query  *Query
+	params map[string]ParamType
+}
+
+// NewQueryTemplate creates a new query template from a query containing placeholders (see FilterParam and
+// WaitConditionParam) and the declaration of their types.
+//
+// The query is copied, thus any later modification to it won't affect the template.
+func NewQueryTemplate(q *Query, params map[string]ParamType) (*QueryTemplate, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	t := &QueryTemplate{
+		query:  q.Clone(),
+		params: map[string]ParamType{},
+	}
+
+	for name, typ := range params {
+		t.params[name] = typ
+	}
+
+	used := map[string]struct{}{}
+	for _, p := range q.params {
+		if _, ok := t.params[p.name]; !ok {
+			return nil, fmt.Errorf("undeclared query parameter %q", p.name)
+		}
+		used[p.name] = struct{}{}
+	}
+
+	for name := range t.params {
+		if _, ok := used[name]; !ok {
+			return nil, fmt.Errorf("unused query parameter %q", name)
+		}
+	}
+
+	return t, nil
+}
+
+// Bind returns a new query having the template placeholders replaced by the given values. An error is returned
+// if a placeholder is left unbound or if a value doesn't match the placeholder declared type.
+func (t *QueryTemplate) Bind(values Params) (*Query, error) {
+	for name := range values {
+		if _, ok := t.params[name]; !ok {
+			return nil, fmt.Errorf("unknown query parameter %q", name)
+		}
+	}
+
+	q := t.query.Clone()
+	q.params = nil
+
+	for _, p := range t.query.params {
+		v, ok := values[p.name]
+		if !ok {
+			return nil, fmt.Errorf("unbound query parameter %q", p.name)
+		} else if typ := t.params[p.name]; !typ.accepts(v) {
+			return nil, fmt.Errorf("invalid value for query parameter %q: expected %s but got %T", p.name, typ, v)
+		}
+
+		rule, err := formatRule(p.field, p.column, p.op, v)
+		if err != nil {
+			return nil, err
+		}
+
+		q.headers[p.index] = p.field + ": " + rule
+	}
+
+	return q, nil
+}
+
+// String returns a string representation of the query template, placeholders being represented by their name
+// surrounded by angle brackets.
+func (t *QueryTemplate) String() string {
+	return t.query.String()
+}
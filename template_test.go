@@ -0,0 +1,83 @@
+package livestatus
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_QueryTemplate(t *testing.T) {
+	expected := `GET table1
+Filter: column1 = abc
+Filter: column2 >= 123
+WaitCondition: column3 > 1439633040
+ResponseHeader: fixed16
+OutputFormat: json
+
+`
+
+	q := NewQuery("table1")
+	q.FilterParam("column1", "=", "param1")
+	q.FilterParam("column2", ">=", "param2")
+	q.WaitConditionParam("column3", ">", "param3")
+
+	tpl, err := NewQueryTemplate(q, map[string]ParamType{
+		"param1": ParamString,
+		"param2": ParamInt,
+		"param3": ParamTime,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := tpl.Bind(Params{
+		"param1": "abc",
+		"param2": 123,
+		"param3": time.Unix(1439633040, 0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if err = result.Err(); err != nil {
+		t.Fatal(err)
+	} else if result.String() != expected {
+		t.Logf("\nExpected %q\nbut got  %q\n", expected, result.String())
+		t.Fail()
+	}
+
+	// Ensure template is left untouched
+	if tpl.query.String() != q.String() {
+		t.Logf("\nExpected %q\nbut got  %q\n", q.String(), tpl.query.String())
+		t.Fail()
+	}
+}
+
+func Test_QueryTemplateInvalid(t *testing.T) {
+	q := NewQuery("table1")
+	q.FilterParam("column1", "=", "param1")
+
+	if _, err := NewQueryTemplate(q, nil); err == nil {
+		t.Logf("\nExpected undeclared parameter error\nbut got  nil\n")
+		t.Fail()
+	}
+
+	if _, err := NewQueryTemplate(q, map[string]ParamType{"param1": ParamInt, "param2": ParamInt}); err == nil {
+		t.Logf("\nExpected unused parameter error\nbut got  nil\n")
+		t.Fail()
+	}
+
+	tpl, err := NewQueryTemplate(q, map[string]ParamType{"param1": ParamInt})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, params := range []Params{
+		{},
+		{"param1": "abc"},
+		{"param1": 1.5},
+		{"param1": 1, "param2": 2},
+	} {
+		if _, err := tpl.Bind(params); err == nil {
+			t.Logf("\nExpected error for %#v\nbut got  nil\n", params)
+			t.Fail()
+		}
+	}
+}
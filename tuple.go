@@ -0,0 +1,215 @@
+package livestatus
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServiceWithState represents an entry of the `services_with_state` column family.
+type ServiceWithState struct {
+	Description    string
+	State          int64
+	HasBeenChecked bool
+}
+
+// ServiceWithInfo represents an entry of the `services_with_info` column family.
+type ServiceWithInfo struct {
+	Description    string
+	State          int64
+	HasBeenChecked bool
+	PluginOutput   string
+}
+
+// HostWithState represents an entry of the host groups `members_with_state` column.
+type HostWithState struct {
+	Name           string
+	State          int64
+	HasBeenChecked bool
+}
+
+// EntryWithInfo represents an entry of the `downtimes_with_info` and `comments_with_info` column families.
+type EntryWithInfo struct {
+	ID      int64
+	Author  string
+	Comment string
+}
+
+// CommentWithExtraInfo represents an entry of the `comments_with_extra_info` column family.
+type CommentWithExtraInfo struct {
+	ID        int64
+	Author    string
+	Comment   string
+	EntryType int64
+	EntryTime time.Time
+}
+
+// GetServicesWithState returns the decoded values of a `services_with_state` column.
+func (r Record) GetServicesWithState(column string) ([]ServiceWithState, error) {
+	tuples, err := r.getTuples(column, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ServiceWithState, len(tuples))
+	for i, t := range tuples {
+		if out[i].Description, err = t.str(0); err != nil {
+			return nil, err
+		} else if out[i].State, err = t.int(1); err != nil {
+			return nil, err
+		} else if out[i].HasBeenChecked, err = t.bool(2); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GetServicesWithInfo returns the decoded values of a `services_with_info` column.
+func (r Record) GetServicesWithInfo(column string) ([]ServiceWithInfo, error) {
+	tuples, err := r.getTuples(column, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ServiceWithInfo, len(tuples))
+	for i, t := range tuples {
+		if out[i].Description, err = t.str(0); err != nil {
+			return nil, err
+		} else if out[i].State, err = t.int(1); err != nil {
+			return nil, err
+		} else if out[i].HasBeenChecked, err = t.bool(2); err != nil {
+			return nil, err
+		} else if out[i].PluginOutput, err = t.str(3); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GetHostsWithState returns the decoded values of a host group `members_with_state` column.
+func (r Record) GetHostsWithState(column string) ([]HostWithState, error) {
+	tuples, err := r.getTuples(column, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]HostWithState, len(tuples))
+	for i, t := range tuples {
+		if out[i].Name, err = t.str(0); err != nil {
+			return nil, err
+		} else if out[i].State, err = t.int(1); err != nil {
+			return nil, err
+		} else if out[i].HasBeenChecked, err = t.bool(2); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GetEntriesWithInfo returns the decoded values of a `downtimes_with_info` or `comments_with_info` column.
+func (r Record) GetEntriesWithInfo(column string) ([]EntryWithInfo, error) {
+	tuples, err := r.getTuples(column, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]EntryWithInfo, len(tuples))
+	for i, t := range tuples {
+		if out[i].ID, err = t.int(0); err != nil {
+			return nil, err
+		} else if out[i].Author, err = t.str(1); err != nil {
+			return nil, err
+		} else if out[i].Comment, err = t.str(2); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// GetCommentsWithExtraInfo returns the decoded values of a `comments_with_extra_info` column.
+func (r Record) GetCommentsWithExtraInfo(column string) ([]CommentWithExtraInfo, error) {
+	tuples, err := r.getTuples(column, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]CommentWithExtraInfo, len(tuples))
+	for i, t := range tuples {
+		var ts int64
+
+		if out[i].ID, err = t.int(0); err != nil {
+			return nil, err
+		} else if out[i].Author, err = t.str(1); err != nil {
+			return nil, err
+		} else if out[i].Comment, err = t.str(2); err != nil {
+			return nil, err
+		} else if out[i].EntryType, err = t.int(3); err != nil {
+			return nil, err
+		} else if ts, err = t.int(4); err != nil {
+			return nil, err
+		}
+		out[i].EntryTime = time.Unix(ts, 0)
+	}
+
+	return out, nil
+}
+
+type tuple struct {
+	column string
+	index  int
+	values []interface{}
+}
+
+func (r Record) getTuples(column string, size int) ([]tuple, error) {
+	values, err := r.GetSlice(column)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]tuple, len(values))
+	for i, v := range values {
+		t, ok := v.([]interface{})
+		if !ok {
+			return nil, elementError(column, i, "tuple", v)
+		} else if len(t) != size {
+			return nil, typeError(column, fmt.Sprintf("[%d]", i), fmt.Sprintf("tuple of %d values", size), v)
+		}
+		out[i] = tuple{column: column, index: i, values: t}
+	}
+
+	return out, nil
+}
+
+func (t tuple) str(i int) (string, error) {
+	s, ok := t.values[i].(string)
+	if !ok {
+		return "", t.error(i, "string")
+	}
+
+	return s, nil
+}
+
+func (t tuple) int(i int) (int64, error) {
+	n, ok := toInt(t.values[i])
+	if !ok {
+		return 0, t.error(i, "integer")
+	}
+
+	return n, nil
+}
+
+func (t tuple) bool(i int) (bool, error) {
+	n, ok := toInt(t.values[i])
+	if !ok {
+		return false, t.error(i, "boolean")
+	}
+
+	return n != 0, nil
+}
+
+func (t tuple) error(i int, expected string) error {
+	return typeError(t.column, fmt.Sprintf("[%d][%d]", t.index, i), expected, t.values[i])
+}
@@ -0,0 +1,130 @@
+package livestatus
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_RecordGetServicesWithState(t *testing.T) {
+	record := Record{
+		"services_with_state": []interface{}{
+			[]interface{}{"service1", 0.0, 1.0},
+			[]interface{}{"service2", 2.0, 0.0},
+		},
+	}
+
+	expected := []ServiceWithState{
+		{Description: "service1", State: 0, HasBeenChecked: true},
+		{Description: "service2", State: 2, HasBeenChecked: false},
+	}
+
+	result, err := record.GetServicesWithState("services_with_state")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetServicesWithInfo(t *testing.T) {
+	record := Record{
+		"services_with_info": []interface{}{
+			[]interface{}{"service1", 1.0, 1.0, "output1"},
+		},
+	}
+
+	expected := []ServiceWithInfo{
+		{Description: "service1", State: 1, HasBeenChecked: true, PluginOutput: "output1"},
+	}
+
+	result, err := record.GetServicesWithInfo("services_with_info")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetHostsWithState(t *testing.T) {
+	record := Record{
+		"members_with_state": []interface{}{
+			[]interface{}{"host1", 1.0, 1.0},
+		},
+	}
+
+	expected := []HostWithState{
+		{Name: "host1", State: 1, HasBeenChecked: true},
+	}
+
+	result, err := record.GetHostsWithState("members_with_state")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetEntriesWithInfo(t *testing.T) {
+	record := Record{
+		"downtimes_with_info": []interface{}{
+			[]interface{}{12.0, "author1", "comment1"},
+		},
+	}
+
+	expected := []EntryWithInfo{
+		{ID: 12, Author: "author1", Comment: "comment1"},
+	}
+
+	result, err := record.GetEntriesWithInfo("downtimes_with_info")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetCommentsWithExtraInfo(t *testing.T) {
+	record := Record{
+		"comments_with_extra_info": []interface{}{
+			[]interface{}{12.0, "author1", "comment1", 1.0, 1.439633040e9},
+		},
+	}
+
+	expected := []CommentWithExtraInfo{
+		{ID: 12, Author: "author1", Comment: "comment1", EntryType: 1, EntryTime: time.Unix(1439633040, 0)},
+	}
+
+	result, err := record.GetCommentsWithExtraInfo("comments_with_extra_info")
+	if err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(result, expected) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", expected, result)
+		t.Fail()
+	}
+}
+
+func Test_RecordGetTuplesInvalid(t *testing.T) {
+	record := Record{
+		"not_tuple":  []interface{}{"service1"},
+		"wrong_size": []interface{}{[]interface{}{"service1", 0.0}},
+		"wrong_type": []interface{}{[]interface{}{"service1", "0", 1.0}},
+	}
+
+	for _, column := range []string{"not_tuple", "wrong_size", "wrong_type"} {
+		if _, err := record.GetServicesWithState(column); !errors.Is(err, ErrInvalidType) {
+			t.Logf("\nExpected %#v for %s\nbut got  %#v\n", ErrInvalidType, column, err)
+			t.Fail()
+		}
+	}
+
+	if _, err := record.GetServicesWithState("unknown"); !errors.Is(err, ErrUnknownColumn) {
+		t.Logf("\nExpected %#v\nbut got  %#v\n", ErrUnknownColumn, err)
+		t.Fail()
+	}
+}
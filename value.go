@@ -0,0 +1,82 @@
+package livestatus
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// formatValue returns the Livestatus protocol representation of a value. Values of named types (e.g. enums) are
+// formatted according to their underlying kind, even when implementing fmt.Stringer.
+func formatValue(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case time.Time:
+		return strconv.FormatInt(v.Unix(), 10), nil
+
+	case time.Duration:
+		return strconv.FormatInt(int64(v/time.Second), 10), nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+
+	case reflect.Bool:
+		if rv.Bool() {
+			return "1", nil
+		}
+		return "0", nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	}
+
+	if s, ok := v.(fmt.Stringer); ok {
+		return s.String(), nil
+	}
+
+	return "", fmt.Errorf("unsupported value type %T", v)
+}
+
+// SanitizeComment returns a free-text value (e.g. an acknowledgement comment or a plugin output) made safe for
+// any command argument position: line breaks and other control characters are replaced by spaces, and ';' field
+// separators by commas.
+func SanitizeComment(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r < 0x20 || r == 0x7f:
+			return ' '
+		case r == ';':
+			return ','
+		}
+		return r
+	}, s)
+}
+
+// checkValue ensures a value doesn't contain any control character, as they could be used to inject
+// additional headers or commands into the request.
+func checkValue(field, v string) error {
+	for i, r := range v {
+		if r < 0x20 || r == 0x7f {
+			return UnsafeValueError{
+				Field:    field,
+				Value:    v,
+				Position: i,
+			}
+		}
+	}
+
+	return nil
+}
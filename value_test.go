@@ -0,0 +1,63 @@
+package livestatus
+
+import (
+	"testing"
+	"time"
+)
+
+type testEnum int
+
+func (e testEnum) String() string {
+	return "name"
+}
+
+type testStringer struct{}
+
+func (s testStringer) String() string {
+	return "stringer"
+}
+
+func Test_FormatValue(t *testing.T) {
+	for _, tc := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{"abc", "abc"},
+		{true, "1"},
+		{false, "0"},
+		{-12, "-12"},
+		{uint8(12), "12"},
+		{1.5, "1.5"},
+		{time.Unix(1439633040, 0), "1439633040"},
+		{90 * time.Second, "90"},
+		{testEnum(2), "2"},
+		{float32(0.5), "0.5"},
+		{testStringer{}, "stringer"},
+	} {
+		result, err := formatValue(tc.value)
+		if err != nil {
+			t.Fatal(err)
+		} else if result != tc.expected {
+			t.Logf("\nExpected %q\nbut got  %q\n", tc.expected, result)
+			t.Fail()
+		}
+	}
+
+	if _, err := formatValue([]string{"a"}); err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+}
+
+func Test_CheckValue(t *testing.T) {
+	if err := checkValue("field1", "abc def;ghi"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []string{"abc\ndef", "abc\rdef", "abc\x00", "\x7f"} {
+		if _, ok := checkValue("field1", v).(UnsafeValueError); !ok {
+			t.Logf("\nExpected UnsafeValueError for %q\n", v)
+			t.Fail()
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package livestatus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultWatchInterval is the default interval between two executions of a watched query.
+	DefaultWatchInterval = 10 * time.Second
+
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = time.Minute
+
+	// lowestBackoff prevents retrying failed executions in a tight loop
+	lowestBackoff = 10 * time.Millisecond
+)
+
+// WatchEventType represents the type of a watch event.
+type WatchEventType int
+
+const (
+	// WatchAdded represents a record appearing in the query results.
+	WatchAdded WatchEventType = iota
+	// WatchRemoved represents a record disappearing from the query results.
+	WatchRemoved
+	// WatchChanged represents a record whose column values have changed.
+	WatchChanged
+)
+
+func (t WatchEventType) String() string {
+	switch t {
+	case WatchAdded:
+		return "added"
+	case WatchRemoved:
+		return "removed"
+	case WatchChanged:
+		return "changed"
+	}
+
+	return fmt.Sprintf("WatchEventType(%d)", int(t))
+}
+
+// ColumnChange represents the old and new values of a changed column.
+type ColumnChange struct {
+	Old interface{}
+	New interface{}
+}
+
+// WatchEvent represents a record-level change event emitted by a Watcher.
+type WatchEvent struct {
+	Type WatchEventType
+
+	// Key contains the values of the record primary key columns.
+	Key []interface{}
+
+	// Old is the previous version of the record, nil for WatchAdded events.
+	Old Record
+	// New is the current version of the record, nil for WatchRemoved events.
+	New Record
+
+	// Changes contains the changed columns for WatchChanged events.
+	Changes map[string]ColumnChange
+}
+
+// Watcher represents a Livestatus query watcher, executing a query periodically and emitting events for each
+// record added, removed or changed between two executions.
+//
+// The watcher uses the client exclusively while running, thus it must not be shared with other callers.
+type Watcher struct {
+	client   *Client
+	query    *Query
+	keys     []string
+	interval time.Duration
+	backoff  backoff
+	onError  func(error)
+
+	mu  sync.Mutex
+	err error
+}
+
+// NewWatcher creates a new watcher for a given query, records being identified by the values of the given
+// primary key columns (e.g. `host_name` and `description` for the `services` table).
+func NewWatcher(c *Client, q *Query, keys ...string) *Watcher {
+	return &Watcher{
+		client:   c,
+		query:    q.Clone(),
+		keys:     keys,
+		interval: DefaultWatchInterval,
+		backoff:  backoff{min: defaultMinBackoff, max: defaultMaxBackoff},
+	}
+}
+
+// Interval sets the interval between two executions of the watched query.
+func (w *Watcher) Interval(d time.Duration) *Watcher {
+	w.interval = d
+	return w
+}
+
+// Backoff sets the minimum and maximum delays to wait before retrying after a failed query execution, the delay
+// being doubled after each consecutive failure. Delays lower than 10ms are raised to 10ms.
+func (w *Watcher) Backoff(min, max time.Duration) *Watcher {
+	if min < lowestBackoff {
+		min = lowestBackoff
+	}
+	if max < min {
+		max = min
+	}
+
+	w.backoff = backoff{min: min, max: max}
+	return w
+}
+
+// OnError sets a function called each time a query execution fails.
+func (w *Watcher) OnError(fn func(error)) *Watcher {
+	w.onError = fn
+	return w
+}
+
+// Run watches the query until the context is cancelled, calling fn for each emitted event. The first execution
+// emits a WatchAdded event for each record. Failed executions are retried with backoff, the records state
+// being preserved in between.
+func (w *Watcher) Run(ctx context.Context, fn func(WatchEvent)) error {
+	if len(w.keys) == 0 {
+		return fmt.Errorf("watcher requires at least one key column")
+	} else if err := w.query.Err(); err != nil {
+		return err
+	}
+
+	defer w.client.Close()
+
+	state := map[string]Record{}
+
+	for {
+		delay := w.interval
+
+		resp, err := w.client.Exec(w.query)
+		if err != nil {
+			// Ensure next attempt uses a fresh connection
+			w.client.Close()
+
+			if w.onError != nil {
+				w.onError(err)
+			}
+			delay = w.backoff.next()
+		} else {
+			w.backoff.reset()
+			state = w.diff(state, resp.Records, fn)
+		}
+
+		if !sleepContext(ctx, delay) {
+			return ctx.Err()
+		}
+	}
+}
+
+// Events watches the query in a separate goroutine until the context is cancelled, returning a channel on which
+// the events are sent. The channel is closed once the watcher is stopped, Err returning the error having stopped it.
+func (w *Watcher) Events(ctx context.Context) <-chan WatchEvent {
+	ch := make(chan WatchEvent)
+
+	go func() {
+		defer close(ch)
+
+		err := w.Run(ctx, func(e WatchEvent) {
+			select {
+			case ch <- e:
+			case <-ctx.Done():
+			}
+		})
+		if err != nil && err != ctx.Err() {
+			w.mu.Lock()
+			w.err = err
+			w.mu.Unlock()
+
+			if w.onError != nil {
+				w.onError(err)
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Err returns the error having stopped the watcher started using Events, or nil if it is still running or has been
+// stopped by cancelling its context.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.err
+}
+
+func (w *Watcher) diff(state map[string]Record, records []Record, fn func(WatchEvent)) map[string]Record {
+	next := map[string]Record{}
+
+	for _, r := range records {
+		key, values := w.key(r)
+		next[key] = r
+
+		old, ok := state[key]
+		if !ok {
+			fn(WatchEvent{Type: WatchAdded, Key: values, New: r})
+			continue
+		}
+
+		if changes := diffRecords(old, r); len(changes) > 0 {
+			fn(WatchEvent{Type: WatchChanged, Key: values, Old: old, New: r, Changes: changes})
+		}
+	}
+
+	for key, old := range state {
+		if _, ok := next[key]; !ok {
+			_, values := w.key(old)
+			fn(WatchEvent{Type: WatchRemoved, Key: values, Old: old})
+		}
+	}
+
+	return next
+}
+
+func (w *Watcher) key(r Record) (string, []interface{}) {
+	parts := make([]string, len(w.keys))
+	values := make([]interface{}, len(w.keys))
+
+	for i, k := range w.keys {
+		values[i] = r[k]
+		parts[i] = fmt.Sprintf("%v", r[k])
+	}
+
+	return strings.Join(parts, "\x00"), values
+}
+
+func diffRecords(old, new Record) map[string]ColumnChange {
+	changes := map[string]ColumnChange{}
+
+	for k, v := range new {
+		if ov, ok := old[k]; !ok || !reflect.DeepEqual(ov, v) {
+			changes[k] = ColumnChange{Old: ov, New: v}
+		}
+	}
+
+	for k, ov := range old {
+		if _, ok := new[k]; !ok {
+			changes[k] = ColumnChange{Old: ov}
+		}
+	}
+
+	return changes
+}
+
+type backoff struct {
+	min     time.Duration
+	max     time.Duration
+	current time.Duration
+}
+
+func (b *backoff) next() time.Duration {
+	if b.current == 0 {
+		b.current = b.min
+	} else if b.current *= 2; b.current > b.max {
+		b.current = b.max
+	}
+
+	return b.current
+}
+
+func (b *backoff) reset() {
+	b.current = 0
+}
+
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,115 @@
+package livestatus
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_Watcher(t *testing.T) {
+	var count int32
+
+	bodies := []string{
+		`[["host1","service1",0],["host1","service2",0]]`,
+		``,
+		`[["host1","service1",2],["host2","service1",0]]`,
+	}
+
+	addr, stop := newTestServer(t, func(req string) (int, string) {
+		n := int(atomic.AddInt32(&count, 1)) - 1
+		if n >= len(bodies) {
+			n = len(bodies) - 1
+		}
+
+		if bodies[n] == "" {
+			return 500, "failure\n"
+		}
+		return 200, bodies[n]
+	})
+	defer stop()
+
+	q := NewQuery("services").Columns("host_name", "description", "state")
+
+	errors := 0
+
+	w := NewWatcher(NewClient("tcp", addr), q, "host_name", "description").
+		Interval(time.Millisecond).
+		Backoff(time.Millisecond, time.Millisecond).
+		OnError(func(err error) { errors++ })
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	events := []WatchEvent{}
+	for e := range w.Events(ctx) {
+		events = append(events, e)
+		if len(events) == 5 {
+			cancel()
+		}
+	}
+
+	if len(events) != 5 {
+		t.Fatalf("\nExpected 5 events\nbut got  %d\n", len(events))
+	}
+
+	for i, typ := range []WatchEventType{WatchAdded, WatchAdded, WatchChanged, WatchAdded, WatchRemoved} {
+		if events[i].Type != typ {
+			t.Logf("\nExpected %s for event #%d\nbut got  %s\n", typ, i, events[i].Type)
+			t.Fail()
+		}
+	}
+
+	change, ok := events[2].Changes["state"]
+	if !ok || len(events[2].Changes) != 1 || change.Old != int64(0) || change.New != int64(2) {
+		t.Logf("\nUnexpected changes %#v\n", events[2].Changes)
+		t.Fail()
+	} else if events[4].Key[1] != "service2" {
+		t.Logf("\nExpected service2\nbut got  %#v\n", events[4].Key)
+		t.Fail()
+	}
+
+	if errors != 1 {
+		t.Logf("\nExpected 1 error\nbut got  %d\n", errors)
+		t.Fail()
+	}
+}
+
+func Test_Backoff(t *testing.T) {
+	b := backoff{min: time.Second, max: 5 * time.Second}
+
+	for _, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if d := b.next(); d != expected {
+			t.Logf("\nExpected %s\nbut got  %s\n", expected, d)
+			t.Fail()
+		}
+	}
+
+	b.reset()
+
+	if d := b.next(); d != time.Second {
+		t.Logf("\nExpected %s\nbut got  %s\n", time.Second, d)
+		t.Fail()
+	}
+}
+
+func Test_WatcherBackoff(t *testing.T) {
+	w := NewWatcher(NewClient("tcp", "127.0.0.1:0"), NewQuery("hosts")).Backoff(0, 0)
+
+	if d := w.backoff.next(); d != lowestBackoff {
+		t.Logf("\nExpected %s\nbut got  %s\n", lowestBackoff, d)
+		t.Fail()
+	}
+}
+
+func Test_WatcherEventsErr(t *testing.T) {
+	w := NewWatcher(NewClient("tcp", "127.0.0.1:0"), NewQuery("hosts"))
+
+	for range w.Events(context.Background()) {
+	}
+
+	if err := w.Err(); err == nil {
+		t.Logf("\nExpected error\nbut got  nil\n")
+		t.Fail()
+	}
+}